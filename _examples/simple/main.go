@@ -61,19 +61,19 @@ func (g *myGame) Draw(screen *ebiten.Image) {
 }
 
 type mySceneController struct {
-	seq        int
-	scene      *gscene.Scene
-	spawnDelay float64
+	seq           int
+	scene         *gscene.Scene
+	spawnCooldown *gscene.Cooldown
 }
 
 func (c *mySceneController) Init(ctx gscene.InitContext) {
 	c.scene = ctx.Scene
+	c.spawnCooldown = ctx.Scene.NewCooldown(0)
 }
 
 func (c *mySceneController) Update(delta float64) {
-	c.spawnDelay -= delta
-	if c.spawnDelay <= 0 {
-		c.spawnDelay = 2 * random.Float64()
+	if c.spawnCooldown.IsReady() {
+		c.spawnCooldown.TriggerFor(2 * random.Float64())
 		o := &myObject{id: c.seq}
 		c.scene.AddObject(o)
 		c.seq++
@@ -85,10 +85,10 @@ func (c *mySceneController) Update(delta float64) {
 // It's assigned a randomized speed upon initialization.
 // It also uses a label object as its graphics.
 type myObject struct {
-	id    int
-	pos   [2]float64
-	speed float64
-	label *myLabel
+	id        int
+	transform gscene.Transform
+	speed     float64
+	label     *myLabel
 }
 
 func (o *myObject) Dispose() {
@@ -100,42 +100,39 @@ func (o *myObject) IsDisposed() bool {
 }
 
 func (o *myObject) Init(scene *gscene.Scene) {
-	o.pos[1] = random.Float64() * float64(screenHeight)
+	o.transform.Pos[1] = random.Float64() * float64(screenHeight)
 
 	o.speed = 40 * (random.Float64() + 0.5)
 
-	// Note: we're "binding" the position of the graphics
+	// Note: we're "binding" the transform of the graphics
 	// to the logical object field.
-	// This way, there is only one source of truth: the object's pos value.
+	// This way, there is only one source of truth: the object's transform value.
 	// The object itself updates the position inside its update
-	// while the bound graphics just read that new value through the pointer.
+	// while the attached graphics get that new value applied during the Draw.
 	o.label = &myLabel{
 		text: fmt.Sprintf("object%d", o.id),
-		pos:  &o.pos,
 	}
-	scene.AddGraphics(o.label, 0)
+	scene.AttachGraphics(o.label, &o.transform, 0)
 }
 
 func (o *myObject) Update(delta float64) {
 	// Slide throught the X axis and check whether we
 	// should consider this object to be destroyed.
 
-	o.pos[0] += o.speed * delta
+	o.transform.Pos[0] += o.speed * delta
 
-	if o.pos[0] >= 0.5*float64(screenWidth) {
+	if o.transform.Pos[0] >= 0.5*float64(screenWidth) {
 		o.Dispose()
 	}
 }
 
-// myLabel implements [gscene.Graphics] interface.
+// myLabel implements [gscene.TransformableGraphics] interface.
 // It renders the provided text at the owner's object position
 // using the debug print function.
 // Note that this is a common pattern: graphical objects
-// should have a pointer to a position, because they don't
-// "own" that position, they just need a way to read the value.
+// don't "own" their position, they get it from the attached transform.
 type myLabel struct {
 	text     string
-	pos      *[2]float64
 	disposed bool
 }
 
@@ -146,5 +143,12 @@ func (l *myLabel) IsDisposed() bool {
 }
 
 func (l *myLabel) Draw(dst *ebiten.Image) {
-	ebitenutil.DebugPrintAt(dst, l.text, int(l.pos[0]), int(l.pos[1]))
+	l.DrawTransformed(dst, ebiten.GeoM{})
+}
+
+func (l *myLabel) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	// The debug print can't rotate or scale the text,
+	// so only the translation part is used.
+	x, y := geom.Apply(0, 0)
+	ebitenutil.DebugPrintAt(dst, l.text, int(x), int(y))
 }
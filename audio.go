@@ -0,0 +1,83 @@
+package gscene
+
+// AudioPlayer is a scene-owned audio player.
+// The ebiten audio.Player type implements it.
+//
+// See [Scene.OwnAudio].
+type AudioPlayer interface {
+	Pause()
+	Close() error
+	Volume() float64
+	SetVolume(volume float64)
+}
+
+type fadingPlayer struct {
+	p      AudioPlayer
+	volume float64
+	step   float64
+}
+
+// OwnAudio binds the audio player lifetime to the scene.
+//
+// When the scene is replaced, the player is paused and closed,
+// so the music doesn't carry over into the next scene by accident.
+// If the manager has the audio fade-out enabled (see [Manager.SetAudioFadeOut]),
+// the player is faded out first.
+//
+// Players that should outlive the scene should not be registered here.
+func (s *Scene) OwnAudio(p AudioPlayer) {
+	s.audioPlayers = append(s.audioPlayers, p)
+}
+
+// SetAudioFadeOut configures the scene change audio transition.
+//
+// The audio players owned by the replaced scene (see [Scene.OwnAudio])
+// get their volume decreased to zero during the specified
+// number of seconds; then they're paused and closed.
+// The fade-out progresses with the update deltas (before the time scale).
+//
+// A zero duration means "stop the players immediately", this is the default.
+func (m *Manager) SetAudioFadeOut(seconds float64) {
+	if seconds < 0 {
+		panic("audio fade-out duration can't be negative")
+	}
+	m.audioFadeOut = seconds
+}
+
+// releaseAudio stops the audio players of the scene being replaced.
+func (m *Manager) releaseAudio(s *Scene) {
+	for _, p := range s.audioPlayers {
+		if m.audioFadeOut == 0 {
+			stopAudioPlayer(p)
+			continue
+		}
+		volume := p.Volume()
+		m.fadingPlayers = append(m.fadingPlayers, fadingPlayer{
+			p:      p,
+			volume: volume,
+			step:   volume / m.audioFadeOut,
+		})
+	}
+	s.audioPlayers = nil
+}
+
+func (m *Manager) stepAudioFade(delta float64) {
+	live := m.fadingPlayers[:0]
+	for _, fp := range m.fadingPlayers {
+		fp.volume -= fp.step * delta
+		if fp.volume <= 0 {
+			stopAudioPlayer(fp.p)
+			continue
+		}
+		fp.p.SetVolume(fp.volume)
+		live = append(live, fp)
+	}
+	clear(m.fadingPlayers[len(live):])
+	m.fadingPlayers = live
+}
+
+func stopAudioPlayer(p AudioPlayer) {
+	p.Pause()
+	// There is nothing we can do about the close error here.
+	_ = p.Close()
+}
@@ -0,0 +1,145 @@
+package gscene
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// ReferenceLeak describes a reference to an object of a disposed scene
+// that was found by the reference audit.
+// See [Manager.EnableReferenceAudit].
+type ReferenceLeak struct {
+	// Root is the audit root that holds the reference.
+	Root any
+
+	// Path describes how the reference is reachable from the root,
+	// like ".player.target" or ".units[3]".
+	Path string
+
+	// Object is the disposed scene object (or controller) being referenced.
+	Object any
+}
+
+// ReferenceAuditor is an optional interface for the audit roots.
+//
+// Roots that implement it are not inspected via reflection;
+// they report their references to the visit callback instead.
+type ReferenceAuditor interface {
+	AuditReferences(visit func(path string, v any))
+}
+
+type referenceAudit struct {
+	roots  []any
+	report func(ReferenceLeak)
+}
+
+// EnableReferenceAudit enables the cross-scene leak detection.
+//
+// Every time a scene is disposed (by [ChangeScene], including
+// the stacked scenes, or by [PopScene]), all registered audit roots
+// (see [AddAuditRoot]) are inspected for the references to its objects.
+// Every such reference is passed to the report callback.
+// Such references keep the entire old scene alive in memory.
+//
+// The roots are walked via reflection, including the unexported fields,
+// unless they implement the [ReferenceAuditor] interface.
+// This is slow, so it's intended to be used in the debug builds only.
+func (m *Manager) EnableReferenceAudit(report func(ReferenceLeak)) {
+	m.referenceAudit = &referenceAudit{report: report}
+}
+
+// AddAuditRoot registers a long-living value (like a game context)
+// that will be inspected by the reference audit.
+// It's a no-op unless [EnableReferenceAudit] was called.
+func (m *Manager) AddAuditRoot(root any) {
+	if m.referenceAudit == nil {
+		return
+	}
+	m.referenceAudit.roots = append(m.referenceAudit.roots, root)
+}
+
+func (a *referenceAudit) run(s *Scene) {
+	targets := make(map[uintptr]any, len(s.objects)+len(s.addedObjects)+1)
+	addTarget := func(v any) {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			targets[rv.Pointer()] = v
+		}
+	}
+	addTarget(s.controllerImpl())
+	for _, list := range s.objectLists() {
+		for _, so := range list {
+			addTarget(so.o)
+		}
+	}
+
+	for _, root := range a.roots {
+		if ra, ok := root.(ReferenceAuditor); ok {
+			ra.AuditReferences(func(path string, v any) {
+				rv := reflect.ValueOf(v)
+				if rv.Kind() != reflect.Pointer || rv.IsNil() {
+					return
+				}
+				if o, ok := targets[rv.Pointer()]; ok {
+					a.report(ReferenceLeak{Root: root, Path: path, Object: o})
+				}
+			})
+			continue
+		}
+		w := auditWalker{
+			root:    root,
+			targets: targets,
+			report:  a.report,
+			visited: make(map[uintptr]struct{}),
+		}
+		w.walk(reflect.ValueOf(root), "")
+	}
+}
+
+type auditWalker struct {
+	root    any
+	targets map[uintptr]any
+	report  func(ReferenceLeak)
+	visited map[uintptr]struct{}
+}
+
+func (w *auditWalker) walk(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		p := v.Pointer()
+		if o, ok := w.targets[p]; ok && path != "" {
+			w.report(ReferenceLeak{Root: w.root, Path: path, Object: o})
+			return
+		}
+		if _, ok := w.visited[p]; ok {
+			return
+		}
+		w.visited[p] = struct{}{}
+		w.walk(v.Elem(), path)
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), path)
+		}
+
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			w.walk(v.Field(i), path+"."+t.Field(i).Name)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			w.walk(iter.Value(), path+"[key]")
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestReferenceAuditStackedScenes(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	var leaks []gscene.ReferenceLeak
+	m := gscene.NewManager()
+	m.SetHeadless(true)
+	m.EnableReferenceAudit(func(leak gscene.ReferenceLeak) {
+		leaks = append(leaks, leak)
+	})
+
+	// The game context keeps the objects of every scene.
+	var root struct {
+		objects []*gscenetest.Object
+	}
+	m.AddAuditRoot(&root)
+	addObject := func(name string) {
+		o := &gscenetest.Object{Recorder: r, Name: name}
+		m.CurrentScene().AddObject(o)
+		root.objects = append(root.objects, o)
+	}
+
+	m.ChangeScene(&gscenetest.Controller{Recorder: r})
+	addObject("bottom")
+	m.PushScene(&gscenetest.Controller{Recorder: r, Name: "middle"}, false)
+	addObject("middle")
+	m.PushScene(&gscenetest.Controller{Recorder: r, Name: "top"}, false)
+	addObject("top")
+
+	m.PopScene()
+	if len(leaks) != 1 || leaks[0].Object != root.objects[2] {
+		t.Fatalf("popped scene: have %v leaks, want the top object", leaks)
+	}
+
+	leaks = nil
+	m.ChangeScene(&gscenetest.Controller{Recorder: r, Name: "next"})
+	if len(leaks) != 2 {
+		t.Fatalf("have %d leaks reported, want the bottom and middle objects", len(leaks))
+	}
+	for i, leak := range leaks {
+		if leak.Object != root.objects[0] && leak.Object != root.objects[1] {
+			t.Fatalf("leak %d: unexpected %v object", i, leak.Object)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package gscene
+
+// AutosaveStorage is a persistent storage for the autosave snapshots.
+// See [Manager.EnableAutosave].
+type AutosaveStorage interface {
+	// SaveSnapshot persists the snapshot data replacing the previous one.
+	// The data slice should not be retained after this call returns,
+	// copy it if the actual writing happens in the background.
+	SaveSnapshot(data []byte) error
+
+	// LoadSnapshot returns the persisted snapshot data.
+	// A nil slice means there is no snapshot.
+	LoadSnapshot() ([]byte, error)
+
+	// ClearSnapshot removes the persisted snapshot.
+	ClearSnapshot() error
+}
+
+// AutosaveConfig is an argument type for [Manager.EnableAutosave].
+type AutosaveConfig struct {
+	// Interval is a time in seconds between the snapshots.
+	// It's measured in the update deltas (before the time scale).
+	Interval float64
+
+	// Storage is used to persist the snapshots.
+	Storage AutosaveStorage
+
+	// Snapshot serializes the current scene state.
+	//
+	// A nil value means "use the [Scene.SaveState]".
+	Snapshot func(s *Scene) ([]byte, error)
+
+	// OnError is called when the snapshot or storage operation fails.
+	// A nil value means that errors are ignored.
+	OnError func(err error)
+}
+
+type autosaveState struct {
+	config        AutosaveConfig
+	timer         float64
+	crashSnapshot []byte
+}
+
+// EnableAutosave enables the periodic crash-safe scene snapshots.
+//
+// The snapshots are taken by the [UpdateWithDelta] method
+// after the scene Update tree is finished, so the scene state
+// is always consistent: there are no half-updated frames.
+//
+// A snapshot that is still in the storage during this call means
+// that the previous session didn't finish properly (see [FinishAutosave]).
+// Such snapshot is available via [CrashSnapshot], so the game
+// can offer restoring the progress.
+func (m *Manager) EnableAutosave(config AutosaveConfig) {
+	if config.Snapshot == nil {
+		config.Snapshot = (*Scene).SaveState
+	}
+	state := &autosaveState{config: config}
+	data, err := config.Storage.LoadSnapshot()
+	if err != nil {
+		state.reportError(err)
+	}
+	state.crashSnapshot = data
+	m.autosave = state
+}
+
+// CrashSnapshot returns the snapshot left by the previous session
+// that didn't finish properly. It reports false if there is no such snapshot.
+func (m *Manager) CrashSnapshot() ([]byte, bool) {
+	if m.autosave == nil || m.autosave.crashSnapshot == nil {
+		return nil, false
+	}
+	return m.autosave.crashSnapshot, true
+}
+
+// FinishAutosave stops the autosave and removes the persisted snapshot.
+// It should be called when the game exits normally.
+func (m *Manager) FinishAutosave() {
+	if m.autosave == nil {
+		return
+	}
+	if err := m.autosave.config.Storage.ClearSnapshot(); err != nil {
+		m.autosave.reportError(err)
+	}
+	m.autosave = nil
+}
+
+func (m *Manager) stepAutosave(delta float64) {
+	a := m.autosave
+	a.timer += delta
+	if a.timer < a.config.Interval {
+		return
+	}
+	a.timer = 0
+
+	data, err := a.config.Snapshot(m.currentScene)
+	if err != nil {
+		a.reportError(err)
+		return
+	}
+	if err := a.config.Storage.SaveSnapshot(data); err != nil {
+		a.reportError(err)
+	}
+}
+
+func (a *autosaveState) reportError(err error) {
+	if a.config.OnError != nil {
+		a.config.OnError(err)
+	}
+}
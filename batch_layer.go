@@ -0,0 +1,187 @@
+package gscene
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Quad is a textured rectangle that can be drawn as a part of the batch.
+// See [QuadGraphics].
+type Quad struct {
+	// Src is the source rectangle inside the texture image.
+	Src image.Rectangle
+
+	// GeoM maps the source rectangle (with its Min moved to (0, 0))
+	// to the destination coordinates.
+	GeoM ebiten.GeoM
+
+	ColorScale ebiten.ColorScale
+}
+
+// QuadGraphics is an optional interface for the [Graphics]
+// that is used by the [BatchLayer].
+//
+// The sprites, tiles, and particle systems can implement it
+// to be drawn by a single DrawTriangles call with all other
+// graphics that share the same texture.
+type QuadGraphics interface {
+	Graphics
+
+	// Texture returns the image (usually a texture atlas)
+	// the quads are sampled from.
+	// The graphics are batched only if their Texture returns
+	// exactly the same image object.
+	Texture() *ebiten.Image
+
+	// AppendQuads appends the graphics quads to the slice.
+	// A particle system can append several quads at once.
+	AppendQuads(quads []Quad) []Quad
+}
+
+// BatchLayer is a [Layer] implementation that renders
+// the [QuadGraphics] using a single DrawTriangles call per texture.
+// It's useful for the particle systems and tile-heavy scenes
+// where the number of draw calls becomes a bottleneck.
+//
+// The graphics are drawn in the order they were added;
+// the batch is flushed when the texture changes.
+// Therefore, the graphics should be grouped by the texture
+// to get the best results.
+// Graphics that don't implement [QuadGraphics] are drawn
+// as usual, but they also flush the current batch.
+//
+//	d := gscene.NewLayeredDrawer(3)
+//	d.SetLayer(1, gscene.NewBatchLayer())
+type BatchLayer struct {
+	graphics simpleDrawer
+
+	quads    []Quad
+	vertices []ebiten.Vertex
+	indices  []uint16
+}
+
+// NewBatchLayer creates a batch layer.
+func NewBatchLayer() *BatchLayer {
+	return &BatchLayer{}
+}
+
+// maxBatchQuads is the number of quads that fits a single DrawTriangles call.
+const maxBatchQuads = ebiten.MaxIndicesCount / 6
+
+// Add implements the [Layer] interface.
+func (l *BatchLayer) Add(g Graphics) {
+	l.graphics.AddGraphics(g, 0)
+}
+
+// AddGraphicsBatch implements the [BatchGraphicsAdder] interface.
+func (l *BatchLayer) AddGraphicsBatch(graphics []Graphics, layer int) {
+	l.graphics.AddGraphicsBatch(graphics, layer)
+}
+
+// Update implements the [Layer] interface.
+func (l *BatchLayer) Update(delta float64) {
+	l.graphics.Update(delta)
+}
+
+// ClearGraphics implements the [GraphicsClearer] interface.
+func (l *BatchLayer) ClearGraphics() {
+	l.graphics.ClearGraphics()
+}
+
+// NumGraphics implements the [GraphicsCounter] interface.
+func (l *BatchLayer) NumGraphics() int {
+	return l.graphics.NumGraphics()
+}
+
+// EachGraphics implements the [GraphicsInspector] interface.
+func (l *BatchLayer) EachGraphics(fn func(g Graphics, layer int) bool) {
+	l.graphics.EachGraphics(fn)
+}
+
+// SetInterpolationAlpha implements the [InterpolationAlphaSetter] interface.
+// The alpha is only used for the graphics that are not batched.
+func (l *BatchLayer) SetInterpolationAlpha(alpha float64) {
+	l.graphics.SetInterpolationAlpha(alpha)
+}
+
+// Draw implements the [Layer] interface.
+func (l *BatchLayer) Draw(dst *ebiten.Image) {
+	l.draw(dst, nil)
+}
+
+// DrawTransformed implements the [TransformDrawer] interface.
+// The geom is applied to every quad.
+func (l *BatchLayer) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	l.draw(dst, &geom)
+}
+
+func (l *BatchLayer) draw(dst *ebiten.Image, geom *ebiten.GeoM) {
+	d := &l.graphics
+	var texture *ebiten.Image
+	for _, g := range d.prepare() {
+		qg, ok := g.(QuadGraphics)
+		if !ok {
+			l.flush(dst, texture, geom)
+			drawGraphics(dst, g, geom, d.alpha)
+			continue
+		}
+		if t := qg.Texture(); t != texture {
+			l.flush(dst, texture, geom)
+			texture = t
+		}
+		l.quads = qg.AppendQuads(l.quads)
+	}
+	l.flush(dst, texture, geom)
+}
+
+// flush draws the collected quads.
+func (l *BatchLayer) flush(dst, texture *ebiten.Image, geom *ebiten.GeoM) {
+	quads := l.quads
+	for len(quads) > 0 {
+		n := min(len(quads), maxBatchQuads)
+		l.drawQuads(dst, texture, quads[:n], geom)
+		quads = quads[n:]
+	}
+	clear(l.quads)
+	l.quads = l.quads[:0]
+}
+
+func (l *BatchLayer) drawQuads(dst, texture *ebiten.Image, quads []Quad, geom *ebiten.GeoM) {
+	vertices := l.vertices[:0]
+	indices := l.indices[:0]
+	for i := range quads {
+		q := &quads[i]
+		m := q.GeoM
+		if geom != nil {
+			m.Concat(*geom)
+		}
+		w := float64(q.Src.Dx())
+		h := float64(q.Src.Dy())
+		r, g, b, a := q.ColorScale.R(), q.ColorScale.G(), q.ColorScale.B(), q.ColorScale.A()
+
+		base := uint16(len(vertices))
+		corners := [4][2]float64{{0, 0}, {w, 0}, {0, h}, {w, h}}
+		for _, c := range corners {
+			x, y := m.Apply(c[0], c[1])
+			vertices = append(vertices, ebiten.Vertex{
+				DstX:   float32(x),
+				DstY:   float32(y),
+				SrcX:   float32(float64(q.Src.Min.X) + c[0]),
+				SrcY:   float32(float64(q.Src.Min.Y) + c[1]),
+				ColorR: r,
+				ColorG: g,
+				ColorB: b,
+				ColorA: a,
+			})
+		}
+		indices = append(indices, base, base+1, base+2, base+1, base+3, base+2)
+	}
+
+	var opts ebiten.DrawTrianglesOptions
+	opts.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	dst.DrawTriangles(vertices, indices, texture, &opts)
+
+	l.vertices = vertices
+	l.indices = indices
+}
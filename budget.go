@@ -0,0 +1,105 @@
+package gscene
+
+import (
+	"slices"
+	"time"
+)
+
+// BudgetedUpdater is an optional interface for the scene [Object].
+//
+// It's intended for the low-priority work (AI planning, visibility
+// checks, ambient simulation) that can be spread across the frames.
+// The scene calls BudgetedUpdate methods in a round-robin fashion,
+// as many as the update budget allows (see [Scene.SetUpdateBudget]).
+//
+// The regular Update method is called as usual.
+// The implementers are collected by the scene automatically
+// when they're added via [Scene.AddObject].
+type BudgetedUpdater interface {
+	// BudgetedUpdate receives the scene time elapsed
+	// since the previous BudgetedUpdate call of this object.
+	BudgetedUpdate(delta float64)
+}
+
+type budgetedObject interface {
+	Object
+	BudgetedUpdater
+}
+
+type budgetedEntry struct {
+	o        budgetedObject
+	lastTime float64
+}
+
+// SetUpdateBudget limits the scene Update duration.
+//
+// The [BudgetedUpdater] objects are updated after the regular
+// objects Update and PostUpdate, until the entire scene
+// Update takes the budget time. The objects that didn't fit
+// are updated during the next frames.
+// At least one budgeted object is updated every frame,
+// so the work always progresses.
+//
+// If the scene Update still exceeds the budget, the optional
+// onExceeded function is called with the actual Update duration.
+//
+// A zero budget disables the limit: all budgeted objects
+// are updated every frame. This is the default.
+func (s *Scene) SetUpdateBudget(budget time.Duration, onExceeded func(spent time.Duration)) {
+	s.updateBudget = budget
+	s.onBudgetExceeded = onExceeded
+}
+
+func (s *Scene) stepBudgetedObjects(start time.Time) {
+	live := s.budgeted[:0]
+	for _, e := range s.budgeted {
+		if e.o.IsDisposed() {
+			continue
+		}
+		live = append(live, e)
+	}
+	clear(s.budgeted[len(live):])
+	s.budgeted = live
+
+	// The list can grow during the updates,
+	// only the objects known at this point are visited.
+	n := len(s.budgeted)
+	for i := 0; i < n; i++ {
+		if i > 0 && s.updateBudget > 0 && time.Since(start) >= s.updateBudget {
+			break
+		}
+		if s.budgetCursor >= n {
+			s.budgetCursor = 0
+		}
+		e := &s.budgeted[s.budgetCursor]
+		s.budgetCursor++
+		if e.o.IsDisposed() || s.isObjectPaused(e.o) {
+			continue
+		}
+		e.o.BudgetedUpdate(s.time - e.lastTime)
+		e.lastTime = s.time
+	}
+}
+
+func (s *Scene) removeBudgeted(o budgetedObject) {
+	i := slices.IndexFunc(s.budgeted, func(e budgetedEntry) bool {
+		return e.o == o
+	})
+	if i == -1 {
+		return
+	}
+	s.budgeted = slices.Delete(s.budgeted, i, i+1)
+	// Keep the round-robin position pointing to the same next object.
+	if i < s.budgetCursor {
+		s.budgetCursor--
+	}
+}
+
+func (s *Scene) checkUpdateBudget(start time.Time) {
+	if s.onBudgetExceeded == nil {
+		return
+	}
+	if spent := time.Since(start); spent > s.updateBudget {
+		s.onBudgetExceeded(spent)
+	}
+}
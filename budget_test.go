@@ -0,0 +1,49 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// budgetedObject counts its BudgetedUpdate calls.
+type budgetedObject struct {
+	gscenetest.Object
+	numBudgeted int
+}
+
+func (o *budgetedObject) BudgetedUpdate(delta float64) {
+	o.numBudgeted++
+}
+
+func TestRemovedObjectBudgetedUpdate(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	scene := m.CurrentScene()
+
+	o := &budgetedObject{Object: gscenetest.Object{Recorder: r}}
+	scene.AddObject(o)
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if o.numBudgeted != 1 {
+		t.Fatalf("have %d budgeted updates, want 1", o.numBudgeted)
+	}
+
+	scene.RemoveObject(o)
+	if err := gscenetest.RunFrames(m, 3, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if o.numBudgeted != 2 {
+		t.Fatalf("removed object: have %d budgeted updates, want 2", o.numBudgeted)
+	}
+
+	// A re-added object has only one budgeted entry.
+	scene.AddObject(o)
+	if err := gscenetest.RunFrames(m, 3, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if o.numBudgeted != 4 {
+		t.Fatalf("re-added object: have %d budgeted updates, want 4", o.numBudgeted)
+	}
+}
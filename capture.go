@@ -0,0 +1,37 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// CaptureFrame renders the current scene into a new image.
+//
+// The image has the same size as the destination image of
+// the latest [Draw] call. The stacked scenes (see [PushScene])
+// are rendered too, but the debug overlays and the draw hooks are not.
+//
+// It returns nil if the frame can't be captured: the manager is
+// headless or there was no Draw call yet.
+// The caller owns the returned image.
+func (m *Manager) CaptureFrame() *ebiten.Image {
+	if m.currentScene.headless || m.lastDrawSize.X == 0 || m.lastDrawSize.Y == 0 {
+		return nil
+	}
+	img := ebiten.NewImage(m.lastDrawSize.X, m.lastDrawSize.Y)
+	for _, s := range m.sceneStack {
+		s.drawGraphics(img)
+	}
+	m.currentScene.drawGraphics(img)
+	return img
+}
+
+// SetCaptureOnSceneChange makes the manager capture the last frame
+// of the outgoing scene during every [ChangeScene].
+//
+// The captured image is available via [InitContext.PrevFrame],
+// so the new scene can use it for the transitions like zoom-out,
+// blur, or the "photo" effects of the previous screen.
+// The image is owned by the new scene (see [Scene.OwnResource]).
+func (m *Manager) SetCaptureOnSceneChange(enabled bool) {
+	m.captureOnChange = enabled
+}
@@ -0,0 +1,43 @@
+package gscene
+
+// CommandHandler is an optional interface for the [Controller].
+//
+// It's required by the [Scene.PushCommand] method.
+type CommandHandler interface {
+	// HandleCommand is called for every queued command
+	// in the order they were pushed.
+	HandleCommand(cmd any)
+}
+
+// PushCommand queues a command for the scene controller.
+//
+// The objects can push the side effect commands (spawn, despawn,
+// play sound, etc.) instead of executing them right away.
+// The queue is drained once per frame, right after all objects
+// are updated (including PostUpdate), so the side effects are
+// executed in a deterministic order regardless of the objects update order.
+//
+// The commands are usually values of some game-defined types,
+// the controller can use a type switch to handle them.
+// The commands pushed during the draining are handled during the same frame.
+//
+// It panics if the controller doesn't implement [CommandHandler].
+func (s *Scene) PushCommand(cmd any) {
+	if s.commandHandler == nil {
+		h, ok := s.controllerImpl().(CommandHandler)
+		if !ok {
+			panic("the scene controller doesn't implement CommandHandler")
+		}
+		s.commandHandler = h
+	}
+	s.commands = append(s.commands, cmd)
+}
+
+func (s *Scene) drainCommands() {
+	// The queue can grow during the handling.
+	for i := 0; i < len(s.commands); i++ {
+		s.commandHandler.HandleCommand(s.commands[i])
+	}
+	clear(s.commands)
+	s.commands = s.commands[:0]
+}
@@ -0,0 +1,144 @@
+package gscene
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+type debugChecker struct {
+	objects  map[Object]debugAddRecord
+	graphics map[Graphics]debugAddRecord
+}
+
+type debugAddRecord struct {
+	scene    *Scene
+	callSite string
+}
+
+// SetDebugChecks enables or disables the scene API misuse assertions.
+//
+// With the checks enabled, the scene panics with an explanatory message if:
+//
+//   - objects or graphics are added or removed from inside the Draw tree
+//   - objects or graphics are added after the scene was disposed
+//   - the same object or graphics is added twice (to one or two scenes)
+//
+// Without the checks, such calls may silently corrupt the scene
+// state, cause double updates and draws, or crash later,
+// far away from the actual bug.
+//
+// An object can be added again after the scene removes it.
+// A graphics can be added again after it reports being disposed
+// at the end of some Update.
+//
+// The checks affect the current scene and all new scenes.
+// They have a significant overhead, so they're intended
+// to be used in the dev builds only.
+func (m *Manager) SetDebugChecks(enabled bool) {
+	var checker *debugChecker
+	if enabled {
+		checker = &debugChecker{
+			objects:  make(map[Object]debugAddRecord),
+			graphics: make(map[Graphics]debugAddRecord),
+		}
+	}
+	m.debugChecks = checker
+	if m.currentScene != nil {
+		m.currentScene.debugChecks = checker
+	}
+	for _, s := range m.sceneStack {
+		s.debugChecks = checker
+	}
+}
+
+// checkMutation panics if the scene can't be modified right now.
+// The op is a method name that is used in the panic message.
+func (s *Scene) checkMutation(op string) {
+	if s.debugChecks == nil {
+		return
+	}
+	if s.disposed {
+		panic(op + " called for a disposed scene (is the scene pointer retained after ChangeScene?)")
+	}
+	if s.insideDraw {
+		panic(op + " called from the Draw tree (the scene can only be modified during the Update)")
+	}
+}
+
+func (s *Scene) checkObjectAdd(o Object) {
+	c := s.debugChecks
+	if c == nil || !isPointer(o) {
+		return
+	}
+	site := debugCallSite()
+	if prev, ok := c.objects[o]; ok && !prev.scene.disposed {
+		panic(fmt.Sprintf("%T object is added twice: first at %s, then at %s", o, prev.callSite, site))
+	}
+	c.objects[o] = debugAddRecord{scene: s, callSite: site}
+}
+
+func (s *Scene) checkGraphicsAdd(g Graphics) {
+	c := s.debugChecks
+	if c == nil || !isPointer(g) {
+		return
+	}
+	site := debugCallSite()
+	if prev, ok := c.graphics[g]; ok && !prev.scene.disposed {
+		panic(fmt.Sprintf("%T graphics is added twice: first at %s, then at %s", g, prev.callSite, site))
+	}
+	c.graphics[g] = debugAddRecord{scene: s, callSite: site}
+}
+
+// forgetRemoved drops the records of the objects and graphics
+// that can be legitimately added again.
+func (c *debugChecker) forgetRemoved(removed []sceneObject) {
+	for _, so := range removed {
+		delete(c.objects, so.o)
+	}
+	for g := range c.graphics {
+		if g.IsDisposed() {
+			delete(c.graphics, g)
+		}
+	}
+}
+
+// forgetScene drops all records of the disposed scene.
+// The checker is shared between the scenes, so the records
+// would be kept alive (together with the scene) otherwise.
+func (c *debugChecker) forgetScene(s *Scene) {
+	for o, r := range c.objects {
+		if r.scene == s {
+			delete(c.objects, o)
+		}
+	}
+	for g, r := range c.graphics {
+		if r.scene == s {
+			delete(c.graphics, g)
+		}
+	}
+}
+
+// isPointer reports whether v identity can be tracked.
+// Non-pointer values are copied, so their identity is meaningless.
+func isPointer(v any) bool {
+	return reflect.TypeOf(v).Kind() == reflect.Pointer
+}
+
+// debugCallSite returns the location of the first caller
+// that is not a part of this package.
+func debugCallSite() string {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, "github.com/quasilyte/gscene.") {
+			return fmt.Sprintf("%s:%d", f.File, f.Line)
+		}
+		if !more {
+			return "<unknown>"
+		}
+	}
+}
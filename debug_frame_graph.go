@@ -0,0 +1,80 @@
+package gscene
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// frameGraphSamples is the number of the most recent frames
+// displayed by the frame-time graph.
+const frameGraphSamples = 120
+
+type frameGraph struct {
+	// samples is a ring buffer of the frame Update+Draw times.
+	samples [frameGraphSamples]time.Duration
+	next    int
+}
+
+// SetFrameTimeGraph enables or disables the built-in frame-time graph.
+//
+// The graph displays the Update+Draw time of the recent frames
+// as a bar chart at the bottom-left corner of the screen.
+// The frames that exceed the 1/TPS budget are highlighted.
+//
+// Just like the debug overlay, the graph turns on
+// the scene stats collection.
+func (m *Manager) SetFrameTimeGraph(enabled bool) {
+	if enabled == (m.frameGraph != nil) {
+		return
+	}
+	if enabled {
+		m.frameGraph = &frameGraph{}
+	} else {
+		m.frameGraph = nil
+	}
+	m.syncStatsEnabled()
+}
+
+// FrameTimeGraphEnabled reports whether the frame-time graph is enabled.
+// See [SetFrameTimeGraph].
+func (m *Manager) FrameTimeGraphEnabled() bool {
+	return m.frameGraph != nil
+}
+
+func (g *frameGraph) push(stats *FrameStats) {
+	g.samples[g.next] = stats.updateTime() + stats.DrawTime
+	g.next = (g.next + 1) % frameGraphSamples
+}
+
+var (
+	frameGraphColorOK   = color.RGBA{R: 0x40, G: 0xc0, B: 0x40, A: 0xff}
+	frameGraphColorSlow = color.RGBA{R: 0xe0, G: 0x40, B: 0x40, A: 0xff}
+	frameGraphColorLine = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x80}
+)
+
+func (g *frameGraph) draw(dst *ebiten.Image) {
+	// The graph height fits two frame budgets;
+	// the longer frames are cut at the top.
+	const barWidth = 2
+	const height = 64
+	budget := time.Second / time.Duration(ebiten.TPS())
+	scale := float64(height) / float64(2*budget)
+
+	bounds := dst.Bounds()
+	x0 := float32(bounds.Min.X + 4)
+	bottom := float32(bounds.Max.Y - 4)
+	for i := 0; i < frameGraphSamples; i++ {
+		d := g.samples[(g.next+i)%frameGraphSamples]
+		h := float32(min(float64(d)*scale, height))
+		c := frameGraphColorOK
+		if d > budget {
+			c = frameGraphColorSlow
+		}
+		vector.DrawFilledRect(dst, x0+float32(i*barWidth), bottom-h, barWidth, h, c, false)
+	}
+	budgetY := bottom - float32(float64(budget)*scale)
+	vector.StrokeLine(dst, x0, budgetY, x0+frameGraphSamples*barWidth, budgetY, 1, frameGraphColorLine, false)
+}
@@ -0,0 +1,129 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// DebugAction is a debug subsystem action that can be bound to a hotkey.
+type DebugAction int
+
+const (
+	// DebugToggleGizmos toggles the current scene debug gizmos mode.
+	// See [Scene.SetDebugGizmos].
+	DebugToggleGizmos DebugAction = iota
+
+	// DebugCycleTimeScale switches the manager time scale
+	// to the next preset from the [DebugHotkeysConfig.TimeScalePresets] list.
+	// See [Manager.SetTimeScale].
+	DebugCycleTimeScale
+
+	// DebugToggleOverlay toggles the built-in debug overlay.
+	// See [Manager.SetDebugOverlay].
+	DebugToggleOverlay
+
+	// DebugToggleStepMode toggles the frame-step mode.
+	// See [Manager.SetStepMode].
+	DebugToggleStepMode
+
+	// DebugStepOnce advances the simulation by one frame
+	// while the frame-step mode is enabled.
+	// See [Manager.StepOnce].
+	DebugStepOnce
+
+	// DebugToggleInspector toggles the built-in scene inspector view.
+	// See [Manager.SetDebugInspector].
+	DebugToggleInspector
+
+	// DebugToggleFrameGraph toggles the built-in frame-time graph.
+	// See [Manager.SetFrameTimeGraph].
+	DebugToggleFrameGraph
+)
+
+// DebugHotkeysConfig is an argument type for [Manager.EnableDebugHotkeys].
+type DebugHotkeysConfig struct {
+	// Keys maps the debug actions to their hotkeys.
+	// Actions that are not in this map have no hotkey bound.
+	Keys map[DebugAction]ebiten.Key
+
+	// TimeScalePresets is a list of time scale values
+	// that are used by the [DebugCycleTimeScale] action.
+	//
+	// An empty slice means "use the default presets" (1, 0.25, 0.5, 2, 4).
+	TimeScalePresets []float64
+}
+
+// DefaultDebugHotkeys returns a config that binds
+// every debug action to some F-key.
+func DefaultDebugHotkeys() DebugHotkeysConfig {
+	return DebugHotkeysConfig{
+		Keys: map[DebugAction]ebiten.Key{
+			DebugToggleGizmos:     ebiten.KeyF1,
+			DebugCycleTimeScale:   ebiten.KeyF2,
+			DebugToggleOverlay:    ebiten.KeyF3,
+			DebugToggleStepMode:   ebiten.KeyF4,
+			DebugStepOnce:         ebiten.KeyF5,
+			DebugToggleInspector:  ebiten.KeyF6,
+			DebugToggleFrameGraph: ebiten.KeyF7,
+		},
+	}
+}
+
+// EnableDebugHotkeys wires the debug subsystems to the configured keys.
+//
+// The hotkeys are handled by the [UpdateWithDelta] method
+// before the scene Update tree is executed.
+//
+// It's intended to be used in the dev builds only.
+func (m *Manager) EnableDebugHotkeys(config DebugHotkeysConfig) {
+	if len(config.TimeScalePresets) == 0 {
+		config.TimeScalePresets = []float64{1, 0.25, 0.5, 2, 4}
+	}
+	m.debugHotkeys = &config
+}
+
+// DisableDebugHotkeys removes the hotkeys installed by [EnableDebugHotkeys].
+func (m *Manager) DisableDebugHotkeys() {
+	m.debugHotkeys = nil
+}
+
+func (m *Manager) debugKeyPressed(action DebugAction) bool {
+	k, ok := m.debugHotkeys.Keys[action]
+	return ok && inpututil.IsKeyJustPressed(k)
+}
+
+func (m *Manager) handleDebugHotkeys() {
+	if m.debugKeyPressed(DebugToggleGizmos) {
+		s := m.currentScene
+		s.SetDebugGizmos(!s.DebugGizmosEnabled())
+	}
+
+	if m.debugKeyPressed(DebugToggleOverlay) {
+		m.SetDebugOverlay(!m.DebugOverlayEnabled())
+	}
+	if m.debugKeyPressed(DebugToggleInspector) {
+		m.SetDebugInspector(!m.DebugInspectorEnabled())
+	}
+	if m.debugKeyPressed(DebugToggleFrameGraph) {
+		m.SetFrameTimeGraph(!m.FrameTimeGraphEnabled())
+	}
+
+	if m.debugKeyPressed(DebugCycleTimeScale) {
+		presets := m.debugHotkeys.TimeScalePresets
+		next := presets[0]
+		for i, scale := range presets {
+			if scale == m.timeScale {
+				next = presets[(i+1)%len(presets)]
+				break
+			}
+		}
+		m.SetTimeScale(next)
+	}
+
+	if m.debugKeyPressed(DebugToggleStepMode) {
+		m.SetStepMode(!m.stepMode)
+	}
+	if m.debugKeyPressed(DebugStepOnce) {
+		m.StepOnce()
+	}
+}
@@ -0,0 +1,92 @@
+package gscene
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// inspectorMaxTypes limits the number of object types
+// listed by the inspector view.
+const inspectorMaxTypes = 12
+
+type debugInspector struct {
+	counts map[string]int
+	types  []string
+
+	text strings.Builder
+}
+
+// SetDebugInspector enables or disables the built-in scene inspector view.
+//
+// The view is a compact on-screen version of the [Scene.Inspect] report:
+// the current controller, the scene time, and the most common
+// object types with their counts (the paused and pending objects
+// are counted separately).
+// It's rendered at the top-left corner, after the scene Draw.
+//
+// The report is rebuilt every frame, so it has a significant overhead.
+func (m *Manager) SetDebugInspector(enabled bool) {
+	if enabled == (m.inspector != nil) {
+		return
+	}
+	if enabled {
+		m.inspector = &debugInspector{counts: make(map[string]int)}
+	} else {
+		m.inspector = nil
+	}
+}
+
+// DebugInspectorEnabled reports whether the inspector view is enabled.
+// See [SetDebugInspector].
+func (m *Manager) DebugInspectorEnabled() bool {
+	return m.inspector != nil
+}
+
+func (v *debugInspector) draw(dst *ebiten.Image, s *Scene) {
+	info := s.Inspect()
+
+	paused := 0
+	pending := 0
+	clear(v.counts)
+	for _, o := range info.Objects {
+		v.counts[o.Type]++
+		if o.Paused {
+			paused++
+		}
+		if o.Pending {
+			pending++
+		}
+	}
+	v.types = v.types[:0]
+	for typ := range v.counts {
+		v.types = append(v.types, typ)
+	}
+	slices.SortFunc(v.types, func(a, b string) int {
+		if v.counts[a] != v.counts[b] {
+			return v.counts[b] - v.counts[a]
+		}
+		return strings.Compare(a, b)
+	})
+
+	v.text.Reset()
+	fmt.Fprintf(&v.text, "scene: %s\n", info.Controller)
+	fmt.Fprintf(&v.text, "time: %.2f (tick %d)\n", info.Time, info.Tick)
+	fmt.Fprintf(&v.text, "objects: %d (paused %d, pending %d)\n", len(info.Objects), paused, pending)
+	for i, typ := range v.types {
+		if i == inspectorMaxTypes {
+			fmt.Fprintf(&v.text, "  ... %d more types\n", len(v.types)-i)
+			break
+		}
+		fmt.Fprintf(&v.text, "  %s: %d\n", typ, v.counts[typ])
+	}
+	if info.Graphics != nil {
+		fmt.Fprintf(&v.text, "graphics: %d", len(info.Graphics))
+	}
+
+	bounds := dst.Bounds()
+	ebitenutil.DebugPrintAt(dst, v.text.String(), bounds.Min.X+4, bounds.Min.Y+4)
+}
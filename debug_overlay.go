@@ -0,0 +1,100 @@
+package gscene
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+type debugOverlay struct {
+	last FrameStats
+
+	// The add/remove rates are computed over a ~1 second window.
+	windowStart   time.Time
+	windowAdded   int
+	windowRemoved int
+	addRate       float64
+	removeRate    float64
+
+	text strings.Builder
+}
+
+// SetDebugOverlay enables or disables the built-in debug overlay.
+//
+// The overlay displays the objects and graphics counts,
+// the per-frame Update and Draw timings, and the objects add/remove rates.
+// It's rendered on top of everything else, after the scene Draw.
+//
+// Enabling the overlay turns on the scene stats collection,
+// so it has some performance overhead.
+func (m *Manager) SetDebugOverlay(enabled bool) {
+	if enabled == (m.debugOverlay != nil) {
+		return
+	}
+	if enabled {
+		m.debugOverlay = &debugOverlay{}
+	} else {
+		m.debugOverlay = nil
+	}
+	m.syncStatsEnabled()
+}
+
+// DebugOverlayEnabled reports whether the debug overlay is enabled.
+// See [SetDebugOverlay].
+func (m *Manager) DebugOverlayEnabled() bool {
+	return m.debugOverlay != nil
+}
+
+func (o *debugOverlay) push(stats *FrameStats) {
+	o.last = *stats
+
+	now := time.Now()
+	if o.windowStart.IsZero() {
+		o.windowStart = now
+	}
+	o.windowAdded += stats.ObjectsAdded
+	o.windowRemoved += stats.ObjectsRemoved
+	if elapsed := now.Sub(o.windowStart).Seconds(); elapsed >= 1 {
+		o.addRate = float64(o.windowAdded) / elapsed
+		o.removeRate = float64(o.windowRemoved) / elapsed
+		o.windowStart = now
+		o.windowAdded = 0
+		o.windowRemoved = 0
+	}
+}
+
+func (o *debugOverlay) draw(dst *ebiten.Image) {
+	s := &o.last
+	updateTime := s.updateTime()
+
+	o.text.Reset()
+	fmt.Fprintf(&o.text, "objects: %d\n", s.NumObjects)
+	if s.NumGraphics >= 0 {
+		fmt.Fprintf(&o.text, "graphics: %d\n", s.NumGraphics)
+	}
+	fmt.Fprintf(&o.text, "update: %.2fms\n", durationMillis(updateTime))
+	fmt.Fprintf(&o.text, "draw: %.2fms\n", durationMillis(s.DrawTime))
+	fmt.Fprintf(&o.text, "added/s: %.1f\n", o.addRate)
+	fmt.Fprintf(&o.text, "removed/s: %.1f", o.removeRate)
+
+	// The overlay is placed at the top-right corner.
+	// The debug font glyphs are 6 pixels wide.
+	const glyphWidth = 6
+	const maxLineLen = 16
+	bounds := dst.Bounds()
+	x := bounds.Max.X - maxLineLen*glyphWidth - 4
+	ebitenutil.DebugPrintAt(dst, o.text.String(), x, bounds.Min.Y+4)
+}
+
+// updateTime returns the total measured scene Update time.
+func (s *FrameStats) updateTime() time.Duration {
+	return s.FixedUpdateTime + s.ControllerUpdateTime +
+		s.ObjectsUpdateTime + s.PostUpdateTime + s.DrawerUpdateTime
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
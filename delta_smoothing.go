@@ -0,0 +1,87 @@
+package gscene
+
+import (
+	"slices"
+)
+
+// DeltaSmoothing is a frame delta smoothing filter kind.
+// See [Manager.SetDeltaSmoothing].
+type DeltaSmoothing int
+
+const (
+	// DeltaSmoothingNone passes the deltas as is.
+	// This is a default mode.
+	DeltaSmoothingNone DeltaSmoothing = iota
+
+	// DeltaSmoothingAverage uses a moving average of the last N deltas.
+	DeltaSmoothingAverage
+
+	// DeltaSmoothingMedian uses a median of the last N deltas.
+	// It's more resistant to the single-frame spikes than the average.
+	DeltaSmoothingMedian
+)
+
+type deltaSmoother struct {
+	mode    DeltaSmoothing
+	samples []float64
+	next    int
+	sorted  []float64
+}
+
+// SetDeltaSmoothing enables the delta smoothing for [UpdateWithDelta].
+//
+// The window specifies the number of the last frame deltas
+// that are used to compute the smoothed value.
+// This soaks up single-frame spikes before they reach the gameplay code.
+//
+// The unfiltered delta is still available via [RawDelta].
+func (m *Manager) SetDeltaSmoothing(mode DeltaSmoothing, window int) {
+	if mode == DeltaSmoothingNone {
+		m.deltaSmoother = nil
+		return
+	}
+	if window <= 0 {
+		panic("delta smoothing window should be positive")
+	}
+	m.deltaSmoother = &deltaSmoother{
+		mode:    mode,
+		samples: make([]float64, 0, window),
+		sorted:  make([]float64, 0, window),
+	}
+}
+
+// RawDelta returns the last delta passed to [UpdateWithDelta]
+// before any filtering was applied to it.
+func (m *Manager) RawDelta() float64 {
+	return m.rawDelta
+}
+
+func (s *deltaSmoother) Filter(delta float64) float64 {
+	if len(s.samples) < cap(s.samples) {
+		s.samples = append(s.samples, delta)
+	} else {
+		s.samples[s.next] = delta
+		s.next = (s.next + 1) % len(s.samples)
+	}
+
+	switch s.mode {
+	case DeltaSmoothingAverage:
+		sum := 0.0
+		for _, x := range s.samples {
+			sum += x
+		}
+		return sum / float64(len(s.samples))
+
+	case DeltaSmoothingMedian:
+		s.sorted = append(s.sorted[:0], s.samples...)
+		slices.Sort(s.sorted)
+		mid := len(s.sorted) / 2
+		if len(s.sorted)%2 == 0 {
+			return (s.sorted[mid-1] + s.sorted[mid]) / 2
+		}
+		return s.sorted[mid]
+
+	default:
+		return delta
+	}
+}
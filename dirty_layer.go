@@ -0,0 +1,154 @@
+package gscene
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// DirtyRectGraphics is an optional interface for the [Graphics]
+// that is used by the [DirtyRectLayer].
+type DirtyRectGraphics interface {
+	Graphics
+
+	// AppendDirtyRects appends the destination areas that should be
+	// redrawn because this graphics has changed since the previous call.
+	// A moved graphics usually reports both its old and new areas.
+	//
+	// The graphics should reset its dirty state after this call.
+	AppendDirtyRects(rects []image.Rectangle) []image.Rectangle
+}
+
+// DirtyRectLayer is a [Layer] implementation that caches its
+// contents and redraws only the areas that have changed.
+//
+// Board games and UI-heavy scenes usually change only a small part
+// of the screen every frame, so this layer saves a lot of GPU work.
+//
+// The layer is fully redrawn when:
+//
+//   - some graphics doesn't implement [DirtyRectGraphics]
+//   - a graphics is added or removed
+//   - the destination size or the transform changes
+//   - [Invalidate] is called (e.g. after the draw order change)
+//
+// Otherwise, only the dirty areas are cleared and redrawn;
+// the rest of the layer is copied from the cache.
+type DirtyRectLayer struct {
+	graphics simpleDrawer
+
+	cache *ebiten.Image
+	geom  ebiten.GeoM
+
+	fullRedraw bool
+	rects      []image.Rectangle
+}
+
+// NewDirtyRectLayer creates a dirty-rect layer.
+func NewDirtyRectLayer() *DirtyRectLayer {
+	return &DirtyRectLayer{fullRedraw: true}
+}
+
+// Invalidate forces the full layer redraw during the next Draw.
+func (l *DirtyRectLayer) Invalidate() {
+	l.fullRedraw = true
+}
+
+// Add implements the [Layer] interface.
+func (l *DirtyRectLayer) Add(g Graphics) {
+	l.graphics.AddGraphics(g, 0)
+	l.fullRedraw = true
+}
+
+// AddGraphicsBatch implements the [BatchGraphicsAdder] interface.
+func (l *DirtyRectLayer) AddGraphicsBatch(graphics []Graphics, layer int) {
+	l.graphics.AddGraphicsBatch(graphics, layer)
+	l.fullRedraw = true
+}
+
+// Update implements the [Layer] interface.
+func (l *DirtyRectLayer) Update(delta float64) {
+	l.graphics.Update(delta)
+}
+
+// ClearGraphics implements the [GraphicsClearer] interface.
+func (l *DirtyRectLayer) ClearGraphics() {
+	l.graphics.ClearGraphics()
+	l.fullRedraw = true
+}
+
+// NumGraphics implements the [GraphicsCounter] interface.
+func (l *DirtyRectLayer) NumGraphics() int {
+	return l.graphics.NumGraphics()
+}
+
+// EachGraphics implements the [GraphicsInspector] interface.
+func (l *DirtyRectLayer) EachGraphics(fn func(g Graphics, layer int) bool) {
+	l.graphics.EachGraphics(fn)
+}
+
+// Draw implements the [Layer] interface.
+func (l *DirtyRectLayer) Draw(dst *ebiten.Image) {
+	l.draw(dst, ebiten.GeoM{})
+}
+
+// DrawTransformed implements the [TransformDrawer] interface.
+func (l *DirtyRectLayer) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	l.draw(dst, geom)
+}
+
+func (l *DirtyRectLayer) draw(dst *ebiten.Image, geom ebiten.GeoM) {
+	n := len(l.graphics.graphics)
+	graphics := l.graphics.prepare()
+	if len(graphics) != n {
+		l.fullRedraw = true
+	}
+
+	// The cache covers the [0, dst.Max] area, so the graphics
+	// can use the same coordinates as they would for dst.
+	bounds := dst.Bounds()
+	if l.cache == nil || l.cache.Bounds().Max != bounds.Max {
+		l.cache = ensureBuffer(l.cache, bounds.Max.X, bounds.Max.Y)
+		l.fullRedraw = true
+	}
+	if l.geom != geom {
+		l.geom = geom
+		l.fullRedraw = true
+	}
+
+	l.rects = l.rects[:0]
+	for _, g := range graphics {
+		dg, ok := g.(DirtyRectGraphics)
+		if !ok {
+			l.fullRedraw = true
+			continue
+		}
+		// The dirty state should be consumed every frame,
+		// even if the layer is fully redrawn anyway.
+		l.rects = dg.AppendDirtyRects(l.rects)
+	}
+
+	if l.fullRedraw {
+		l.fullRedraw = false
+		l.redraw(l.cache)
+	} else {
+		for _, r := range l.rects {
+			r = r.Intersect(l.cache.Bounds())
+			if r.Empty() {
+				continue
+			}
+			l.redraw(l.cache.SubImage(r).(*ebiten.Image))
+		}
+	}
+
+	var opts ebiten.DrawImageOptions
+	opts.GeoM.Translate(float64(bounds.Min.X), float64(bounds.Min.Y))
+	dst.DrawImage(l.cache.SubImage(bounds).(*ebiten.Image), &opts)
+}
+
+// redraw clears the area and draws all graphics into it.
+// The graphics drawing is clipped by the area bounds.
+func (l *DirtyRectLayer) redraw(area *ebiten.Image) {
+	area.Clear()
+	l.graphics.draw(area, optionalGeoM(l.geom))
+}
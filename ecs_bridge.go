@@ -0,0 +1,109 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ECSBridgeConfig is an argument type for [NewECSBridge].
+//
+// The bridge doesn't depend on any particular ECS library,
+// the hooks below should call into the game's ECS world.
+type ECSBridgeConfig struct {
+	// Init is called when the bridge is added to the scene.
+	// A nil value means "do nothing".
+	Init func(s *Scene)
+
+	// Update runs the ECS world update systems.
+	// The delta is the same as every scene [Object] receives.
+	Update func(delta float64)
+
+	// Dispose is called once when the bridge is disposed.
+	// It's a good place to release the ECS world resources.
+	// A nil value means "do nothing".
+	Dispose func()
+}
+
+// ECSBridge adapts an ECS world (like a donburi World) to the scene.
+//
+// The world is updated as a single scene [Object]:
+// all its update systems run during the bridge Update call,
+// in the same order as other scene objects are updated.
+//
+// The world render systems become scene [Graphics],
+// see [AddRenderSystem]. This way, the ECS subsystems
+// can be mixed with the plain scene objects and graphics.
+type ECSBridge struct {
+	config ECSBridgeConfig
+
+	scene         *Scene
+	renderSystems []ecsRenderSystem
+
+	disposed bool
+}
+
+type ecsRenderSystem struct {
+	bridge *ECSBridge
+	draw   func(dst *ebiten.Image)
+	layer  int
+}
+
+func (r *ecsRenderSystem) Draw(dst *ebiten.Image) { r.draw(dst) }
+
+func (r *ecsRenderSystem) IsDisposed() bool { return r.bridge.disposed }
+
+// NewECSBridge creates a bridge that should be added to the scene
+// using the [Scene.AddObject].
+func NewECSBridge(config ECSBridgeConfig) *ECSBridge {
+	if config.Update == nil {
+		panic("ECS bridge config has no Update func")
+	}
+	return &ECSBridge{config: config}
+}
+
+// AddRenderSystem registers a render system as scene graphics.
+//
+// The render systems registered before the bridge is added
+// to the scene are attached to it during the bridge Init.
+// They're removed from the scene together with the bridge.
+func (b *ECSBridge) AddRenderSystem(layer int, draw func(dst *ebiten.Image)) {
+	r := ecsRenderSystem{bridge: b, draw: draw, layer: layer}
+	if b.scene == nil {
+		b.renderSystems = append(b.renderSystems, r)
+		return
+	}
+	b.scene.AddGraphics(&r, layer)
+}
+
+// Init implements the [Object] interface.
+func (b *ECSBridge) Init(s *Scene) {
+	b.scene = s
+	for i := range b.renderSystems {
+		r := &b.renderSystems[i]
+		s.AddGraphics(r, r.layer)
+	}
+	b.renderSystems = nil
+	if b.config.Init != nil {
+		b.config.Init(s)
+	}
+}
+
+// Update implements the [Object] interface.
+func (b *ECSBridge) Update(delta float64) {
+	b.config.Update(delta)
+}
+
+// IsDisposed implements the [Object] interface.
+func (b *ECSBridge) IsDisposed() bool {
+	return b.disposed
+}
+
+// Dispose removes the bridge and all its render systems from the scene.
+func (b *ECSBridge) Dispose() {
+	if b.disposed {
+		return
+	}
+	b.disposed = true
+	if b.config.Dispose != nil {
+		b.config.Dispose()
+	}
+}
@@ -0,0 +1,36 @@
+package gscene
+
+// FindObject returns the first scene object of type T.
+//
+// T can be a concrete type (like *Player) or an interface
+// (like Damageable). Disposed objects are ignored.
+// The search order is identical to [Scene.EachObject] order.
+//
+// This is a linear scan over all scene objects, so it should
+// not be used for the per-frame lookups in the big scenes.
+func FindObject[T any](s *Scene) (T, bool) {
+	var result T
+	found := false
+	s.EachObject(func(o Object) bool {
+		if v, ok := o.(T); ok {
+			result = v
+			found = true
+			return false
+		}
+		return true
+	})
+	return result, found
+}
+
+// FindAllObjects is like [FindObject], but it returns
+// all scene objects of type T.
+func FindAllObjects[T any](s *Scene) []T {
+	var result []T
+	s.EachObject(func(o Object) bool {
+		if v, ok := o.(T); ok {
+			result = append(result, v)
+		}
+		return true
+	})
+	return result
+}
@@ -0,0 +1,55 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// SetPauseOnFocusLoss makes the manager stop the scene updates
+// while the game window has no focus.
+//
+// It's only relevant if the game is runnable on unfocused
+// (see [ebiten.SetRunnableOnUnfocused]); otherwise ebiten
+// doesn't call Update for unfocused windows anyway.
+//
+// The scene controller can implement the [FocusObserver]
+// interface to get notified about the focus changes
+// (to show a pause menu, for example).
+// The Draw calls are not affected.
+//
+// The callbacks always come in pairs: disabling this mode
+// while the window is unfocused resumes the updates with
+// an OnFocusGained call, and a scene that becomes current while
+// the window is unfocused gets its OnFocusLost call right after Init.
+func (m *Manager) SetPauseOnFocusLoss(enabled bool) {
+	m.pauseOnFocusLoss = enabled
+	if !enabled {
+		m.focusLost = false
+	}
+	if m.currentScene != nil {
+		m.syncSceneFocus(m.currentScene)
+	}
+}
+
+// handleFocus reports whether the scene update should be skipped.
+func (m *Manager) handleFocus() bool {
+	m.focusLost = !ebiten.IsFocused()
+	m.syncSceneFocus(m.currentScene)
+	return m.focusLost
+}
+
+// syncSceneFocus sends the focus callback to the scene controller
+// if its last known focus state differs from the actual one.
+func (m *Manager) syncSceneFocus(s *Scene) {
+	lost := m.pauseOnFocusLoss && m.focusLost
+	if s.focusLost == lost {
+		return
+	}
+	s.focusLost = lost
+	if o, ok := s.controllerImpl().(FocusObserver); ok {
+		if lost {
+			o.OnFocusLost()
+		} else {
+			o.OnFocusGained()
+		}
+	}
+}
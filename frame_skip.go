@@ -0,0 +1,138 @@
+package gscene
+
+import (
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// FrameSkipConfig is an argument type for [Manager.EnableFrameSkip].
+type FrameSkipConfig struct {
+	// Budget is the expected real time between two rendered frames
+	// per one update.
+	// A zero value means "1/TPS" (see [ebiten.TPS]).
+	Budget time.Duration
+
+	// MaxUpdates limits the number of updates executed
+	// by a single Update call.
+	// A zero value means "use the default value" (4).
+	MaxUpdates int
+
+	// SlowFrames is the number of consecutive frames that should
+	// fall behind (or keep up with) the budget to turn
+	// the frame skipping on (or off).
+	// A zero value means "use the default value" (10).
+	SlowFrames int
+}
+
+type frameSkip struct {
+	config FrameSkipConfig
+
+	active bool
+	streak int
+
+	// lastDraw is the previous Draw start time.
+	lastDraw time.Time
+
+	// updates counts the Update calls since the previous Draw.
+	// Ebitengine runs several Update calls per frame on its own
+	// when the rendering falls behind, they're not compensated twice.
+	updates int
+
+	// debt is the number of updates the real time calls for
+	// minus the number of updates that were actually executed.
+	// It's fractional as the frame rate can differ from the TPS.
+	debt float64
+
+	// drawn is set after every Draw, so only the first Update
+	// after the rendered frame runs the extra updates.
+	drawn bool
+}
+
+// EnableFrameSkip turns on the frame skipping for the low-end devices.
+//
+// Ebitengine already tries to keep the gameplay speed when
+// the rendering is slow: it calls the game Update several times
+// per frame to catch up with the TPS (see [ebiten.SetTPS]).
+// This catch-up has its limits though, so on the devices that
+// can't keep up for a long time the game slows down.
+//
+// The frame skipping compensates the remaining difference.
+// The manager measures the real time between the rendered frames
+// and compares it with the number of updates that were actually
+// executed during that time (including the Ebitengine catch-up updates).
+// When the updates consistently fall behind, the missing updates
+// are executed by the first Update call after the rendered frame.
+// The total number of updates per frame never exceeds the MaxUpdates.
+// The mode is turned off as soon as the game keeps up again.
+//
+// It's only useful with the [DeltaFixed] mode:
+// the real time deltas already account for the slow frames.
+func (m *Manager) EnableFrameSkip(config FrameSkipConfig) {
+	if config.MaxUpdates == 0 {
+		config.MaxUpdates = 4
+	}
+	if config.SlowFrames == 0 {
+		config.SlowFrames = 10
+	}
+	m.frameSkip = &frameSkip{config: config}
+}
+
+// DisableFrameSkip turns off the mode enabled by [EnableFrameSkip].
+func (m *Manager) DisableFrameSkip() {
+	m.frameSkip = nil
+}
+
+// IsFrameSkipping reports whether the frame skipping is active right now.
+func (m *Manager) IsFrameSkipping() bool {
+	return m.frameSkip != nil && m.frameSkip.active
+}
+
+// numUpdates returns the number of updates that should be executed
+// by the current Update call.
+func (f *frameSkip) numUpdates() int {
+	n := 1
+	if f.drawn {
+		f.drawn = false
+		if f.active && f.debt >= 1 {
+			n += min(int(f.debt), f.config.MaxUpdates-1)
+		}
+	}
+	f.updates += n
+	return n
+}
+
+func (f *frameSkip) onDraw(now time.Time) {
+	f.drawn = true
+	prev := f.lastDraw
+	f.lastDraw = now
+	executed := f.updates
+	f.updates = 0
+	if prev.IsZero() {
+		return
+	}
+
+	budget := f.config.Budget
+	if budget == 0 {
+		budget = time.Second / time.Duration(ebiten.TPS())
+	}
+	// The Ebitengine catch-up updates are counted as executed,
+	// so only the part it didn't manage to compensate is left.
+	// The debt is limited, so a long stall (like a window drag)
+	// doesn't result in a burst of updates afterwards.
+	maxDebt := float64(f.config.MaxUpdates)
+	f.debt += float64(now.Sub(prev))/float64(budget) - float64(executed)
+	f.debt = math.Max(-maxDebt, math.Min(f.debt, maxDebt))
+
+	slow := f.debt >= 1
+	if slow != f.active {
+		f.streak++
+		if f.streak >= f.config.SlowFrames {
+			f.active = slow
+			f.streak = 0
+		}
+	} else {
+		f.streak = 0
+	}
+}
@@ -0,0 +1,70 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type freezeFrame struct {
+	shader *ebiten.Shader
+	cache  *ebiten.Image
+}
+
+// SetFrozen enables or disables the scene freeze-frame rendering.
+//
+// A frozen scene renders its graphics only once, into a cached image.
+// All following Draw calls just draw that image instead of
+// re-drawing all graphics every frame, which saves the battery
+// on the pause screens. The objects are still updated as usual,
+// so the scene should usually be paused as well (see [Manager.SetPaused]).
+//
+// The optional shader is applied once, when the frame is cached.
+// It receives the rendered frame as its first source image.
+// This is useful for the desaturation or blur effects.
+//
+// A typical pause screen freezes the game scene and
+// pushes the pause menu scene on top of it (see [Manager.PushScene]).
+func (s *Scene) SetFrozen(frozen bool, shader *ebiten.Shader) {
+	if s.freeze != nil && s.freeze.cache != nil {
+		s.freeze.cache.Dispose()
+	}
+	s.freeze = nil
+	if frozen {
+		s.freeze = &freezeFrame{shader: shader}
+	}
+}
+
+// IsFrozen reports whether the scene is frozen.
+// See [SetFrozen].
+func (s *Scene) IsFrozen() bool {
+	return s.freeze != nil
+}
+
+func (s *Scene) drawFrozen(dst *ebiten.Image) {
+	f := s.freeze
+	size := dst.Bounds().Size()
+	if f.cache == nil || f.cache.Bounds().Size() != size {
+		s.renderFreezeFrame(dst)
+	}
+	var opts ebiten.DrawImageOptions
+	opts.GeoM.Translate(float64(dst.Bounds().Min.X), float64(dst.Bounds().Min.Y))
+	dst.DrawImage(f.cache, &opts)
+}
+
+func (s *Scene) renderFreezeFrame(dst *ebiten.Image) {
+	f := s.freeze
+	size := dst.Bounds().Size()
+	f.cache = ensureBuffer(f.cache, size.X, size.Y)
+	f.cache.Clear()
+
+	if f.shader == nil {
+		s.drawGraphics(f.cache)
+		return
+	}
+
+	frame := ebiten.NewImage(size.X, size.Y)
+	defer frame.Dispose()
+	s.drawGraphics(frame)
+	var opts ebiten.DrawRectShaderOptions
+	opts.Images[0] = frame
+	f.cache.DrawRectShader(size.X, size.Y, f.shader, &opts)
+}
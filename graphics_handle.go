@@ -0,0 +1,91 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// GraphicsHandle is a [Graphics] wrapper that can be hidden.
+// See [Scene.AddGraphicsHandle].
+//
+// A hidden graphics is not drawn, but it keeps its place
+// inside the layer, so it's drawn at the same position
+// of the draw order when it's shown again.
+//
+// The handle forwards the [TransformableGraphics], [InterpolatedGraphics],
+// and [OrderedGraphics] methods to the wrapped graphics.
+// Other optional interfaces (like [QuadGraphics]) are not forwarded.
+type GraphicsHandle struct {
+	g      Graphics
+	hidden bool
+}
+
+// AddGraphicsHandle is like [AddGraphics], but the graphics
+// is wrapped into a handle that can be used to hide it
+// temporarily without disposing and re-adding it.
+//
+// The handle is disposed together with the wrapped graphics.
+func (s *Scene) AddGraphicsHandle(g Graphics, layer int) *GraphicsHandle {
+	h := &GraphicsHandle{g: g}
+	s.AddGraphics(h, layer)
+	return h
+}
+
+// Graphics returns the wrapped graphics.
+func (h *GraphicsHandle) Graphics() Graphics {
+	return h.g
+}
+
+// SetVisible shows or hides the graphics.
+// The graphics are visible by default.
+func (h *GraphicsHandle) SetVisible(visible bool) {
+	h.hidden = !visible
+}
+
+// IsVisible reports whether the graphics are visible.
+func (h *GraphicsHandle) IsVisible() bool {
+	return !h.hidden
+}
+
+// IsDisposed implements the [Graphics] interface.
+func (h *GraphicsHandle) IsDisposed() bool {
+	return h.g.IsDisposed()
+}
+
+// Draw implements the [Graphics] interface.
+func (h *GraphicsHandle) Draw(dst *ebiten.Image) {
+	if h.hidden {
+		return
+	}
+	h.g.Draw(dst)
+}
+
+// DrawTransformed implements the [TransformableGraphics] interface.
+// The transform is ignored if the wrapped graphics doesn't implement it.
+func (h *GraphicsHandle) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	if h.hidden {
+		return
+	}
+	if tg, ok := h.g.(TransformableGraphics); ok {
+		tg.DrawTransformed(dst, geom)
+		return
+	}
+	h.g.Draw(dst)
+}
+
+// DrawInterpolated implements the [InterpolatedGraphics] interface.
+// The alpha is ignored if the wrapped graphics doesn't implement it.
+func (h *GraphicsHandle) DrawInterpolated(dst *ebiten.Image, alpha float64) {
+	if h.hidden {
+		return
+	}
+	if ig, ok := h.g.(InterpolatedGraphics); ok {
+		ig.DrawInterpolated(dst, alpha)
+		return
+	}
+	h.g.Draw(dst)
+}
+
+// DrawOrder implements the [OrderedGraphics] interface.
+func (h *GraphicsHandle) DrawOrder() int {
+	return graphicsDrawOrder(h.g)
+}
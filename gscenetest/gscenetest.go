@@ -0,0 +1,65 @@
+// Package gscenetest implements utilities for the gscene-based code testing.
+//
+// Most helpers are intended to be used with a headless manager,
+// see [gscene.Manager.SetHeadless].
+package gscenetest
+
+import (
+	"time"
+
+	"github.com/quasilyte/gscene"
+)
+
+// NewManager creates a headless manager that runs
+// the scene controlled by c.
+//
+// The scene is accessible via [gscene.Manager.CurrentScene]:
+//
+//	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+//	m.CurrentScene().AddObject(o)
+func NewManager(c gscene.Controller) *gscene.Manager {
+	m := gscene.NewManager()
+	m.SetHeadless(true)
+	m.ChangeScene(c)
+	return m
+}
+
+// RunFrames executes n update frames using the fixed delta.
+//
+// It stops early and returns the error if the scene
+// reports a fatal error via [gscene.Scene.Fail].
+func RunFrames(m *gscene.Manager, n int, delta float64) error {
+	for i := 0; i < n; i++ {
+		if err := m.UpdateWithDeltaE(delta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clock is a controllable time source.
+//
+// Use [gscene.Manager.SetClock] to make the manager use it:
+//
+//	clock := gscenetest.NewClock()
+//	m.SetClock(clock.Now)
+//	m.SetDeltaMode(gscene.DeltaRealTime)
+type Clock struct {
+	now time.Time
+}
+
+// NewClock creates a clock that starts at some fixed non-zero time.
+func NewClock() *Clock {
+	return &Clock{now: time.Unix(0, 0)}
+}
+
+// Now returns the current clock time.
+// The time only changes after the [Advance] calls.
+func (c *Clock) Now() time.Time {
+	return c.now
+}
+
+// Advance moves the clock forward.
+func (c *Clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,105 @@
+package gscenetest_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestNewManager(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	if !m.IsHeadless() {
+		t.Fatal("the manager is not headless")
+	}
+	if m.CurrentScene() == nil {
+		t.Fatal("the scene is not started")
+	}
+	if err := r.Check("controller.Init"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunFrames(t *testing.T) {
+	var deltas []float64
+	m := gscenetest.NewManager(&gscenetest.Controller{
+		Recorder: &gscenetest.Recorder{},
+		OnUpdate: func(delta float64) {
+			deltas = append(deltas, delta)
+		},
+	})
+
+	if err := gscenetest.RunFrames(m, 3, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	if len(deltas) != 3 {
+		t.Fatalf("have %d frames, want 3", len(deltas))
+	}
+	for i, d := range deltas {
+		if d != 0.25 {
+			t.Fatalf("frame %d: have %v delta, want 0.25", i, d)
+		}
+	}
+}
+
+func TestRunFramesFail(t *testing.T) {
+	errBroken := errors.New("broken save")
+	var m *gscene.Manager
+	frames := 0
+	m = gscenetest.NewManager(&gscenetest.Controller{
+		Recorder: &gscenetest.Recorder{},
+		OnUpdate: func(delta float64) {
+			frames++
+			if frames == 2 {
+				m.CurrentScene().Fail(errBroken)
+			}
+		},
+	})
+
+	err := gscenetest.RunFrames(m, 10, 1.0/60.0)
+	if !errors.Is(err, errBroken) {
+		t.Fatalf("have %v error, want %v", err, errBroken)
+	}
+	if frames != 2 {
+		t.Fatalf("have %d frames, want 2", frames)
+	}
+}
+
+func TestClock(t *testing.T) {
+	clock := gscenetest.NewClock()
+	if clock.Now().IsZero() {
+		t.Fatal("the clock starts at a zero time")
+	}
+
+	var deltas []float64
+	m := gscenetest.NewManager(&gscenetest.Controller{
+		Recorder: &gscenetest.Recorder{},
+		OnUpdate: func(delta float64) {
+			deltas = append(deltas, delta)
+		},
+	})
+	m.SetClock(clock.Now)
+	m.SetDeltaMode(gscene.DeltaRealTime)
+
+	// The first real time frame has no previous
+	// time point, so it uses the default delta.
+	m.Update()
+	clock.Advance(100 * time.Millisecond)
+	m.Update()
+	m.Update()
+	clock.Advance(250 * time.Millisecond)
+	m.Update()
+
+	want := []float64{1.0 / 60.0, 0.1, 0, 0.25}
+	if len(deltas) != len(want) {
+		t.Fatalf("have %d frames, want %d", len(deltas), len(want))
+	}
+	for i := range want {
+		if deltas[i] != want[i] {
+			t.Fatalf("frame %d: have %v delta, want %v", i, deltas[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package gscenetest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/quasilyte/gscene"
+)
+
+// Recorder collects the lifecycle events of the
+// recording controllers and objects.
+//
+// Events are recorded as "<name>.<method>" strings,
+// like "controller.Init" or "bullet.Update".
+type Recorder struct {
+	events []string
+}
+
+// Events returns the recorded events in the order they happened.
+func (r *Recorder) Events() []string {
+	return r.events
+}
+
+// Reset discards all recorded events.
+func (r *Recorder) Reset() {
+	r.events = r.events[:0]
+}
+
+// Check compares the recorded events with the expected list.
+// It returns a non-nil error describing the first mismatch.
+func (r *Recorder) Check(events ...string) error {
+	for i, want := range events {
+		if i >= len(r.events) {
+			return fmt.Errorf("event %d: expected %q, got nothing (recorded %s)", i, want, r.dump())
+		}
+		if r.events[i] != want {
+			return fmt.Errorf("event %d: expected %q, got %q (recorded %s)", i, want, r.events[i], r.dump())
+		}
+	}
+	if len(r.events) > len(events) {
+		return fmt.Errorf("unexpected event %d: %q (recorded %s)", len(events), r.events[len(events)], r.dump())
+	}
+	return nil
+}
+
+func (r *Recorder) record(name, method string) {
+	r.events = append(r.events, name+"."+method)
+}
+
+func (r *Recorder) dump() string {
+	return "[" + strings.Join(r.events, " ") + "]"
+}
+
+// Controller is a [gscene.Controller] that records its calls.
+//
+// The optional OnInit and OnUpdate functions are called
+// after the event is recorded.
+type Controller struct {
+	Recorder *Recorder
+
+	// Name is used as an events prefix.
+	// An empty name means "controller".
+	Name string
+
+	OnInit   func(ctx gscene.InitContext)
+	OnUpdate func(delta float64)
+}
+
+func (c *Controller) name() string {
+	if c.Name == "" {
+		return "controller"
+	}
+	return c.Name
+}
+
+func (c *Controller) Init(ctx gscene.InitContext) {
+	c.Recorder.record(c.name(), "Init")
+	if c.OnInit != nil {
+		c.OnInit(ctx)
+	}
+}
+
+func (c *Controller) Update(delta float64) {
+	c.Recorder.record(c.name(), "Update")
+	if c.OnUpdate != nil {
+		c.OnUpdate(delta)
+	}
+}
+
+// Object is a [gscene.Object] that records its calls.
+//
+// It also records the [gscene.RemovalObserver] notifications
+// as "<name>.Removed" events.
+type Object struct {
+	Recorder *Recorder
+
+	// Name is used as an events prefix.
+	// An empty name means "object".
+	Name string
+
+	OnInit   func(scene *gscene.Scene)
+	OnUpdate func(delta float64)
+
+	disposed bool
+}
+
+func (o *Object) name() string {
+	if o.Name == "" {
+		return "object"
+	}
+	return o.Name
+}
+
+// Dispose marks the object as disposed.
+func (o *Object) Dispose() {
+	o.disposed = true
+}
+
+func (o *Object) IsDisposed() bool {
+	return o.disposed
+}
+
+func (o *Object) Init(scene *gscene.Scene) {
+	o.Recorder.record(o.name(), "Init")
+	if o.OnInit != nil {
+		o.OnInit(scene)
+	}
+}
+
+func (o *Object) Update(delta float64) {
+	o.Recorder.record(o.name(), "Update")
+	if o.OnUpdate != nil {
+		o.OnUpdate(delta)
+	}
+}
+
+func (o *Object) OnRemovedFromScene(scene *gscene.Scene) {
+	o.Recorder.record(o.name(), "Removed")
+}
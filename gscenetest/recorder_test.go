@@ -0,0 +1,118 @@
+package gscenetest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestRecorderCheck(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	if err := gscenetest.RunFrames(m, 1, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Check("controller.Init", "controller.Update"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		events []string
+		errStr string
+	}{
+		{
+			events: []string{"controller.Init"},
+			errStr: `unexpected event 1: "controller.Update"`,
+		},
+		{
+			events: []string{"controller.Init", "controller.Update", "controller.Update"},
+			errStr: `event 2: expected "controller.Update", got nothing`,
+		},
+		{
+			events: []string{"controller.Init", "object.Update"},
+			errStr: `event 1: expected "object.Update", got "controller.Update"`,
+		},
+	}
+	for _, test := range tests {
+		err := r.Check(test.events...)
+		if err == nil {
+			t.Fatalf("Check(%q) succeeded", test.events)
+		}
+		if !strings.Contains(err.Error(), test.errStr) {
+			t.Fatalf("Check(%q): have %q error, want %q", test.events, err, test.errStr)
+		}
+	}
+
+	r.Reset()
+	if err := r.Check(); err != nil {
+		t.Fatalf("Reset didn't discard the events: %v", err)
+	}
+}
+
+func TestObjectLifecycle(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	a := &gscenetest.Object{Recorder: r, Name: "a"}
+	b := &gscenetest.Object{Recorder: r, Name: "b"}
+	spawned := &gscenetest.Object{Recorder: r, Name: "spawned"}
+	m := gscenetest.NewManager(&gscenetest.Controller{
+		Recorder: r,
+		OnInit: func(ctx gscene.InitContext) {
+			ctx.Scene.AddObject(a)
+			ctx.Scene.AddObject(b)
+		},
+	})
+	scene := m.CurrentScene()
+	if err := r.Check("controller.Init", "a.Init", "b.Init"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The objects added during the Init are updated from the next frame.
+	r.Reset()
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	err := r.Check(
+		"controller.Update",
+		"controller.Update", "a.Update", "b.Update",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An object added from the Update tree is initialized right away,
+	// but it's updated from the next frame as well.
+	r.Reset()
+	a.OnUpdate = func(delta float64) {
+		scene.AddObject(spawned)
+		a.OnUpdate = nil
+	}
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	err = r.Check(
+		"controller.Update", "a.Update", "spawned.Init", "b.Update",
+		"controller.Update", "a.Update", "b.Update", "spawned.Update",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The disposed objects are not updated anymore
+	// and they're removed at the end of the frame.
+	r.Reset()
+	a.Dispose()
+	scene.RemoveObject(spawned)
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	err = r.Check(
+		"controller.Update", "b.Update", "spawned.Update", "a.Removed", "spawned.Removed",
+		"controller.Update", "b.Update",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,41 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// SetHeadless enables or disables the headless mode for the new scenes.
+//
+// A headless scene has no rendering part at all:
+// the graphics passed to AddGraphics are discarded right away
+// and the drawers installed via [InitContext.SetDrawer] are ignored.
+// The objects and the controller are updated as usual,
+// so the same gameplay code can run on a server or inside
+// the unit tests without creating any ebiten images.
+//
+// The mode is applied during the next scene change;
+// the current scene is not affected.
+// Use [RunHeadless] or [UpdateWithDelta] to step the headless scenes.
+// Calling [Draw] for a headless scene is a no-op.
+func (m *Manager) SetHeadless(enabled bool) {
+	m.headless = enabled
+}
+
+// IsHeadless reports whether the headless mode is enabled.
+// See [SetHeadless].
+func (m *Manager) IsHeadless() bool {
+	return m.headless
+}
+
+// headlessDrawer is a drawer that discards all graphics.
+type headlessDrawer struct{}
+
+func (headlessDrawer) AddGraphics(g Graphics, layer int) {}
+
+func (headlessDrawer) Update(delta float64) {}
+
+func (headlessDrawer) Draw(dst *ebiten.Image) {}
+
+func (headlessDrawer) NumGraphics() int { return 0 }
+
+func (headlessDrawer) ClearGraphics() {}
@@ -0,0 +1,53 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// HookEvent identifies the manager game loop point where a hook is called.
+type HookEvent int
+
+const (
+	// BeforeUpdate hooks are called before the scene Update tree.
+	BeforeUpdate HookEvent = iota
+
+	// AfterUpdate hooks are called after the scene Update tree.
+	AfterUpdate
+
+	// BeforeDraw hooks are called before the scene Draw tree.
+	BeforeDraw
+
+	// AfterDraw hooks are called after the scene Draw tree.
+	AfterDraw
+
+	numHookEvents
+)
+
+// HookContext is an argument type for the manager hooks.
+type HookContext struct {
+	Manager *Manager
+
+	// Delta is the frame delta (before the time scale).
+	// It's only set for the update hooks.
+	Delta float64
+
+	// Dst is the draw destination.
+	// It's only set for the draw hooks.
+	Dst *ebiten.Image
+}
+
+// AddHook registers a function to be called at the specified game loop point.
+//
+// Hooks allow the cross-cutting systems like profilers, input snapshots,
+// and screen recorders to plug in without wrapping every controller.
+// Hooks of the same event are called in the registration order.
+func (m *Manager) AddHook(event HookEvent, fn func(ctx HookContext)) {
+	m.hooks[event] = append(m.hooks[event], fn)
+}
+
+func (m *Manager) runHooks(event HookEvent, ctx HookContext) {
+	ctx.Manager = m
+	for _, fn := range m.hooks[event] {
+		fn(ctx)
+	}
+}
@@ -0,0 +1,55 @@
+package gscene
+
+// InputHandler is a scene-scoped input handler.
+//
+// It's usually a thin wrapper around the input library handler
+// (like ebitengine-input Handler) that knows how to deactivate
+// the action handlers registered by the scene.
+// See [Manager.SetInputFactory].
+type InputHandler interface {
+	// Release is called when the owning scene is disposed.
+	// The handler should deactivate all its actions,
+	// so they don't fire for the next scene.
+	Release()
+}
+
+// SetInputFactory makes every scene have its own [InputHandler].
+//
+// The factory is called lazily, during the first [Scene.Input] call.
+// The created handler is released automatically
+// when the scene is replaced by another one.
+//
+// The factory affects only the scenes created after this call.
+func (m *Manager) SetInputFactory(f func(s *Scene) InputHandler) {
+	m.inputFactory = f
+}
+
+// Input returns the scene-scoped input handler.
+// It panics if the manager has no input factory installed.
+//
+// The handlers registered during the [Controller.Init]
+// (or any time later) are released together with the scene.
+//
+// Use [SceneInput] to get the handler of a concrete type.
+func (s *Scene) Input() InputHandler {
+	if s.input == nil {
+		if s.inputFactory == nil {
+			panic("the scene manager has no input factory")
+		}
+		s.input = s.inputFactory(s)
+	}
+	return s.input
+}
+
+// SceneInput is like [Scene.Input], but it returns
+// the handler of the concrete type.
+func SceneInput[T InputHandler](s *Scene) T {
+	return s.Input().(T)
+}
+
+func (s *Scene) releaseInput() {
+	if s.input != nil {
+		s.input.Release()
+		s.input = nil
+	}
+}
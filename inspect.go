@@ -0,0 +1,110 @@
+package gscene
+
+import (
+	"fmt"
+)
+
+// SceneInfo is a read-only scene state description.
+// See [Scene.Inspect].
+type SceneInfo struct {
+	// Controller is a controller type name.
+	Controller string
+
+	Time float64
+	Tick uint64
+
+	// Objects are listed in their Update order.
+	// The objects that are queued for addition are listed last.
+	Objects []ObjectInfo
+
+	// Graphics are listed in their drawing order.
+	// It's nil if the scene drawer doesn't implement [GraphicsInspector].
+	Graphics []GraphicsInfo
+}
+
+// ObjectInfo describes a single scene object.
+type ObjectInfo struct {
+	Object Object
+
+	// Type is an object type name, like "*game.Bullet".
+	Type string
+
+	// Tags are reported by the objects that implement [TaggedObject].
+	Tags []string
+
+	Group UpdateGroup
+
+	// Paused reports whether the object group is paused
+	// either by the scene or by the manager.
+	Paused bool
+
+	// Pending reports whether the object is still queued for addition.
+	Pending bool
+}
+
+// GraphicsInfo describes a single graphics object.
+type GraphicsInfo struct {
+	Graphics Graphics
+
+	// Type is a graphics type name, like "*graphics.Sprite".
+	Type string
+
+	Layer int
+}
+
+// Inspect returns the current scene state description.
+//
+// It's intended to be used by the dev consoles and editor overlays
+// that need to browse the live scene.
+// The returned data is a snapshot: it's not updated
+// when the scene changes.
+//
+// Disposed objects and graphics are not reported.
+func (s *Scene) Inspect() SceneInfo {
+	info := SceneInfo{
+		Controller: fmt.Sprintf("%T", s.controllerImpl()),
+		Time:       s.time,
+		Tick:       s.tick,
+	}
+
+	lists := s.objectLists()
+	for i, list := range lists {
+		pending := i == len(lists)-1
+		for _, so := range list {
+			if so.o.IsDisposed() {
+				continue
+			}
+			info.Objects = append(info.Objects, s.inspectObject(so.o, pending))
+		}
+	}
+
+	if gi, ok := s.drawer.(GraphicsInspector); ok {
+		info.Graphics = []GraphicsInfo{}
+		gi.EachGraphics(func(g Graphics, layer int) bool {
+			info.Graphics = append(info.Graphics, GraphicsInfo{
+				Graphics: g,
+				Type:     fmt.Sprintf("%T", g),
+				Layer:    layer,
+			})
+			return true
+		})
+	}
+
+	return info
+}
+
+func (s *Scene) inspectObject(o Object, pending bool) ObjectInfo {
+	info := ObjectInfo{
+		Object:  o,
+		Type:    fmt.Sprintf("%T", o),
+		Pending: pending,
+	}
+	if t, ok := o.(TaggedObject); ok {
+		info.Tags = t.Tags()
+	}
+	if g, ok := o.(GroupedObject); ok {
+		info.Group = g.UpdateGroup()
+	}
+	info.Paused = s.isGroupStopped(info.Group)
+	return info
+}
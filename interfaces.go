@@ -8,6 +8,15 @@ import (
 // Most notably, the [Scene] is directly available through its field.
 type InitContext struct {
 	Scene *Scene
+
+	// PrevFrame is the last frame of the replaced scene.
+	// It's only set if the capturing is enabled,
+	// see [Manager.SetCaptureOnSceneChange].
+	PrevFrame *ebiten.Image
+
+	// Args is a value passed to the [Manager.ChangeSceneWithArgs]
+	// (or via [SceneOptions]). It's nil if no args were passed.
+	Args any
 }
 
 // SetDrawer changes the scene [Drawer] implementation.
@@ -39,6 +48,44 @@ type Controller interface {
 	Update(delta float64)
 }
 
+// ControllerWithData is like [Controller], but its Init method
+// also receives the typed data passed to [ChangeSceneWith].
+type ControllerWithData[T any] interface {
+	// Init is called once when a new scene is being created.
+	Init(ctx InitContextWithData[T])
+
+	// Update is called at every game's Update cycle.
+	// See [Controller.Update].
+	Update(delta float64)
+}
+
+// InitContextWithData is an argument type for [ControllerWithData.Init].
+// It's identical to [InitContext], but it also carries the scene data.
+type InitContextWithData[T any] struct {
+	InitContext
+
+	Data T
+}
+
+// dataController adapts [ControllerWithData] to the [Controller] interface.
+type dataController[T any] struct {
+	c    ControllerWithData[T]
+	data T
+}
+
+func (c *dataController[T]) Init(ctx InitContext) {
+	c.c.Init(InitContextWithData[T]{InitContext: ctx, Data: c.data})
+}
+
+func (c *dataController[T]) Update(delta float64) { c.c.Update(delta) }
+
+func (c *dataController[T]) unwrap() any { return c.c }
+
+// wrappedController is implemented by the controller adapters.
+type wrappedController interface {
+	unwrap() any
+}
+
 // Object is a scene-managed object those [Update] method will be called
 // as a part of a game loop.
 //
@@ -103,6 +150,19 @@ type Drawer interface {
 	// A layer can do some graphics ordering inside itself as well.
 	// For example, a Y-sort style layer would draw its elements
 	// after sorting them by Y-axis.
+	//
+	// Unless a layer documents its own ordering rules, the graphics
+	// inside a layer should be drawn in the order they were added.
+	// This order must be stable: removing the disposed graphics
+	// must not change the relative order of the remaining ones.
+	// A graphics that is added again after its removal
+	// is treated as the most recently added one.
+	//
+	// If a layer sorts its graphics, the result should only depend
+	// on the current sort keys and the addition order, but not on
+	// the previous frames or the removals that happened in between.
+	// Both built-in drawers follow this contract,
+	// see also [LayeredDrawer.SetLayerSort].
 	AddGraphics(g Graphics, layer int)
 
 	// Update is a [Drawer] hook into [ebiten.Game] Update tree.
@@ -118,3 +178,218 @@ type Drawer interface {
 	// The drawer is expected to draw all its layers to the [dst] image.
 	Draw(dst *ebiten.Image)
 }
+
+// DebugDrawer is an optional interface for the scene [Object].
+//
+// Objects that implement it can visualize their internal state
+// (targets, ranges, FSM labels, etc.) on top of the regular graphics.
+// The DebugDraw method is called only when the scene debug gizmos
+// mode is enabled, see [Scene.SetDebugGizmos].
+//
+// This way, the debug drawing code doesn't pollute the release draws.
+type DebugDrawer interface {
+	// DebugDraw is called after the [Drawer] has finished its Draw.
+	// The call order is identical to the objects Update order.
+	DebugDraw(dst *ebiten.Image)
+}
+
+// PostUpdater is an optional interface for the scene [Object].
+//
+// Objects that implement it get their PostUpdate method called
+// after all [Object.Update] calls of the current frame.
+// It's a good place for camera follow, constraint resolution,
+// or syncing the graphics state.
+//
+// The implementers are collected by the scene automatically
+// when they're added via [Scene.AddObject].
+type PostUpdater interface {
+	PostUpdate(delta float64)
+}
+
+type postUpdaterObject interface {
+	Object
+	PostUpdater
+}
+
+// FixedUpdater is an optional interface for the scene [Object] and [Controller].
+//
+// Objects that implement it get their FixedUpdate method called
+// on the fixed-timestep cadence while the regular Update
+// is still called once per frame with the frame delta.
+// The fixed delta is configured via [Scene.SetFixedDelta].
+//
+// It's useful for the physics engines integration as they
+// usually require constant simulation steps.
+//
+// The implementers are collected by the scene automatically
+// when they're added via [Scene.AddObject].
+type FixedUpdater interface {
+	FixedUpdate(delta float64)
+}
+
+type fixedUpdaterObject interface {
+	Object
+	FixedUpdater
+}
+
+// UpdateGroup is an object update category identifier.
+//
+// Groups can be paused individually, see [Scene.SetGroupPaused].
+// Valid group values are [0, 63].
+//
+// It's up to the game to define its own groups, like:
+//
+//	const (
+//		GroupWorld gscene.UpdateGroup = iota
+//		GroupUI
+//		GroupParticles
+//	)
+type UpdateGroup uint8
+
+// GroupedObject is an optional interface for the scene [Object].
+//
+// Objects that don't implement it belong to the group 0.
+type GroupedObject interface {
+	// UpdateGroup reports the object update group.
+	// The returned value should not change during the object lifetime.
+	UpdateGroup() UpdateGroup
+}
+
+// GraphicsClearer is an optional interface for the [Drawer].
+//
+// It's required by the [Scene.ClearGraphics] method.
+type GraphicsClearer interface {
+	// ClearGraphics removes all graphics from the drawer.
+	// Graphics that have a Dispose() method should be disposed.
+	ClearGraphics()
+}
+
+// GraphicsCounter is an optional interface for the [Drawer].
+//
+// It's used to report the number of graphics in the scene statistics.
+type GraphicsCounter interface {
+	// NumGraphics reports the number of graphics inside the drawer.
+	NumGraphics() int
+}
+
+// RemovalObserver is an optional interface for the scene [Object].
+//
+// The scene calls OnRemovedFromScene exactly once when it drops
+// the object from its list (after the object reports being disposed
+// or after it was removed via [Scene.RemoveObject]).
+// It's a good place to release event subscriptions or pooled resources.
+//
+// The hook is called at the end of the Update cycle.
+// It's not called for the objects of a scene being replaced
+// as the entire scene is discarded at once.
+type RemovalObserver interface {
+	OnRemovedFromScene(s *Scene)
+}
+
+// BatchGraphicsAdder is an optional interface for the [Drawer].
+//
+// It's used by the [Scene.AddGraphicsBatch] method.
+type BatchGraphicsAdder interface {
+	// AddGraphicsBatch is like [Drawer.AddGraphics], but for several graphics.
+	AddGraphicsBatch(graphics []Graphics, layer int)
+}
+
+// GraphicsReserver is an optional interface for the [Drawer].
+//
+// It's used by the [Scene.Reserve] method.
+type GraphicsReserver interface {
+	// ReserveGraphics preallocates the storage for n graphics.
+	ReserveGraphics(n int)
+}
+
+// LayersDrawer is an optional interface for the [Drawer].
+//
+// It's required by the [Viewport] layer subsets, see [ViewportConfig.Layers].
+type LayersDrawer interface {
+	// DrawLayers is like Draw, but only the listed layers are drawn.
+	// The layers are drawn in the order they're listed.
+	//
+	// The geom is applied to every [TransformableGraphics]
+	// being drawn, just like in [TransformDrawer].
+	// An identity geom means that there is no extra transform to apply.
+	DrawLayers(dst *ebiten.Image, geom ebiten.GeoM, layers []int)
+}
+
+// InterpolatedGraphics is an optional interface for the [Graphics].
+//
+// When the game logic runs on the fixed timestep (see [FixedUpdater]),
+// the rendering usually happens somewhere between two simulation steps.
+// Graphics that implement this interface can lerp between the
+// previous and current simulation state to remove the visual judder.
+//
+// The built-in drawers call DrawInterpolated instead of Draw
+// for such graphics. Custom drawers can get the alpha
+// by implementing the [InterpolationAlphaSetter] interface.
+type InterpolatedGraphics interface {
+	Graphics
+
+	// DrawInterpolated is like Draw, but it also receives
+	// the interpolation alpha, see [Scene.FixedAlpha].
+	DrawInterpolated(dst *ebiten.Image, alpha float64)
+}
+
+// InterpolationAlphaSetter is an optional interface for the [Drawer].
+type InterpolationAlphaSetter interface {
+	// SetInterpolationAlpha is called by the scene right before the Draw.
+	SetInterpolationAlpha(alpha float64)
+}
+
+// ThrottledObject is an optional interface for the scene [Object].
+//
+// Objects that implement it are added to the scene
+// as if [Scene.AddObjectThrottled] was used.
+type ThrottledObject interface {
+	// UpdateInterval reports how often the object should be updated.
+	// A value of N means "every N-th frame".
+	// It's called only once, when the object is added to the scene.
+	UpdateInterval() int
+}
+
+// TaggedObject is an optional interface for the scene [Object].
+//
+// The tags are only used for the debugging purposes,
+// see [Scene.Inspect].
+type TaggedObject interface {
+	Tags() []string
+}
+
+// GraphicsInspector is an optional interface for the [Drawer].
+//
+// It's used by the [Scene.Inspect] method to report the graphics layers.
+type GraphicsInspector interface {
+	// EachGraphics calls fn for every graphics that is not disposed.
+	// The graphics should be visited in their drawing order.
+	// The iteration stops as soon as fn returns false.
+	EachGraphics(fn func(g Graphics, layer int) bool)
+}
+
+// FocusObserver is an optional interface for the [Controller].
+// See [Manager.SetPauseOnFocusLoss].
+type FocusObserver interface {
+	// OnFocusLost is called when the game window loses focus.
+	// The scene updates are paused right after this call.
+	OnFocusLost()
+
+	// OnFocusGained is called when the game window gets the focus back.
+	// The scene updates are resumed right after this call.
+	OnFocusGained()
+}
+
+// OrderedGraphics is an optional interface for the [Graphics].
+//
+// The built-in drawers sort the graphics of every layer by their
+// draw order before drawing them: lower values are drawn first.
+// Graphics that don't implement this interface have the order of 0.
+// The sorting is stable, so the graphics with the same order
+// are drawn in the order they were added (even if their
+// order values were different during the previous frames).
+//
+// This gives a basic z-ordering without a custom [Drawer].
+type OrderedGraphics interface {
+	DrawOrder() int
+}
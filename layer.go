@@ -0,0 +1,122 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Layer is a [LayeredDrawer] layer implementation.
+// See [LayeredDrawer.SetLayer].
+//
+// The default layer draws its graphics in the order they were added
+// (respecting the [OrderedGraphics]). A custom layer can sort
+// its graphics differently (e.g. by Y-axis) or batch them.
+//
+// A layer can also implement these optional interfaces:
+//
+//   - [BatchGraphicsAdder] (the layer argument is always 0)
+//   - [GraphicsClearer]
+//   - [GraphicsCounter]
+//   - [GraphicsInspector] (the layer argument is always 0)
+//   - [InterpolationAlphaSetter]
+//   - [TransformDrawer]
+//
+// Without them, some of the drawer features are not available
+// for this layer. For example, [LayeredDrawer.ClearGraphics]
+// can't remove the graphics of a layer that is not a [GraphicsClearer].
+type Layer interface {
+	// Add is like [Drawer.AddGraphics], but without a layer index.
+	Add(g Graphics)
+
+	// Update is called during the drawer Update,
+	// see [LayeredDrawer.SetLayerUpdateInterval].
+	Update(delta float64)
+
+	Draw(dst *ebiten.Image)
+}
+
+// plainLayer is a default [Layer] implementation.
+type plainLayer struct {
+	simpleDrawer
+}
+
+func (l *plainLayer) Add(g Graphics) { l.AddGraphics(g, 0) }
+
+func (l *drawerLayer) add(g Graphics) {
+	l.impl.Add(g)
+}
+
+func (l *drawerLayer) addBatch(graphics []Graphics) {
+	if b, ok := l.impl.(BatchGraphicsAdder); ok {
+		b.AddGraphicsBatch(graphics, 0)
+		return
+	}
+	for _, g := range graphics {
+		l.impl.Add(g)
+	}
+}
+
+func (l *drawerLayer) setAlpha(alpha float64) {
+	l.alpha = alpha
+	if a, ok := l.impl.(InterpolationAlphaSetter); ok {
+		a.SetInterpolationAlpha(alpha)
+	}
+}
+
+func (l *drawerLayer) clear() {
+	if c, ok := l.impl.(GraphicsClearer); ok {
+		c.ClearGraphics()
+	}
+}
+
+func (l *drawerLayer) numGraphics() int {
+	if c, ok := l.impl.(GraphicsCounter); ok {
+		return c.NumGraphics()
+	}
+	return 0
+}
+
+// each is like [GraphicsInspector.EachGraphics], but it reports
+// the specified layer index and whether the iteration was completed.
+func (l *drawerLayer) each(layer int, fn func(g Graphics, layer int) bool) bool {
+	gi, ok := l.impl.(GraphicsInspector)
+	if !ok {
+		return true
+	}
+	completed := true
+	gi.EachGraphics(func(g Graphics, _ int) bool {
+		completed = fn(g, layer)
+		return completed
+	})
+	return completed
+}
+
+// liveGraphics returns the layer graphics that are not disposed.
+// It panics if the layer graphics can't be enumerated,
+// so they're never lost silently.
+func (l *drawerLayer) liveGraphics() []Graphics {
+	if _, ok := l.impl.(GraphicsInspector); !ok {
+		panic("the layer doesn't implement GraphicsInspector")
+	}
+	var list []Graphics
+	l.each(0, func(g Graphics, _ int) bool {
+		list = append(list, g)
+		return true
+	})
+	return list
+}
+
+// drawContents draws the layer graphics without the masking.
+// A nil geom means that there is no extra transform to apply.
+func (l *drawerLayer) drawContents(dst *ebiten.Image, geom *ebiten.GeoM) {
+	if p, ok := l.impl.(*plainLayer); ok {
+		p.draw(dst, geom)
+		return
+	}
+	if geom != nil {
+		if td, ok := l.impl.(TransformDrawer); ok {
+			td.DrawTransformed(dst, *geom)
+			return
+		}
+	}
+	l.impl.Draw(dst)
+}
@@ -0,0 +1,363 @@
+package gscene
+
+import (
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// LayeredDrawer is a [Drawer] implementation with multiple layers.
+//
+// Unlike the default drawer, it respects the layer index argument
+// of AddGraphics. Layers are drawn in the index order, so
+// higher layers are drawn on top of lower ones.
+// Inside every default layer, graphics are rendered in the order they were added.
+//
+// Layers can be masked, see [SetLayerMask] and [SetLayerMaskLayer].
+// Layers can be inserted and removed at runtime, see [InsertLayer]
+// and [RemoveLayer].
+// Custom layer implementations can be installed via [SetLayer].
+type LayeredDrawer struct {
+	layers []*drawerLayer
+}
+
+// LayerOptions configures a layer created by [LayeredDrawer.InsertLayer].
+type LayerOptions struct {
+	// Mask is an optional layer mask.
+	// See [LayeredDrawer.SetLayerMask].
+	Mask Graphics
+
+	// UpdateInterval is a layer update interval.
+	// A zero value means "update every frame".
+	// See [LayeredDrawer.SetLayerUpdateInterval].
+	UpdateInterval int
+
+	// Layer is a custom layer implementation.
+	// A nil value means "use the default implementation".
+	// See [LayeredDrawer.SetLayer].
+	Layer Layer
+}
+
+type drawerLayer struct {
+	impl  Layer
+	alpha float64
+
+	mask   Graphics
+	isMask bool
+
+	updateInterval int
+	updateCounter  int
+
+	buf     *ebiten.Image
+	maskBuf *ebiten.Image
+}
+
+// layerMask adapts the drawer layer to the [Graphics] interface,
+// so it can be used as a mask for another layer.
+type layerMask struct {
+	layer *drawerLayer
+}
+
+func (m *layerMask) Draw(dst *ebiten.Image) { m.layer.drawContents(dst, nil) }
+
+func (m *layerMask) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	m.layer.drawContents(dst, &geom)
+}
+
+func (m *layerMask) IsDisposed() bool { return false }
+
+// NewLayeredDrawer creates a drawer with the specified number of layers.
+// Valid layer indexes are [0, numLayers).
+//
+// Use [InitContext.SetDrawer] to install it for the scene.
+func NewLayeredDrawer(numLayers int) *LayeredDrawer {
+	if numLayers <= 0 {
+		panic("a layered drawer needs at least one layer")
+	}
+	d := &LayeredDrawer{
+		layers: make([]*drawerLayer, numLayers),
+	}
+	for i := range d.layers {
+		d.layers[i] = &drawerLayer{impl: &plainLayer{}}
+	}
+	return d
+}
+
+// NumLayers reports the current number of layers.
+func (d *LayeredDrawer) NumLayers() int {
+	return len(d.layers)
+}
+
+// InsertLayer creates a new layer at the specified index.
+//
+// Layers at this index and above are shifted up by one.
+// The index can be equal to [NumLayers] to append a new top layer.
+//
+// This is useful for the temporary effect layers (boss fights,
+// special modes) that don't need to be declared upfront.
+func (d *LayeredDrawer) InsertLayer(index int, opts LayerOptions) {
+	impl := opts.Layer
+	if impl == nil {
+		impl = &plainLayer{}
+	}
+	l := &drawerLayer{
+		impl:           impl,
+		mask:           opts.Mask,
+		updateInterval: opts.UpdateInterval,
+	}
+	d.layers = slices.Insert(d.layers, index, l)
+}
+
+// RemoveLayer removes the layer at the specified index and
+// disposes all its graphics (the ones that have a Dispose() method).
+//
+// Layers above this index are shifted down by one.
+// If the removed layer was used as a mask, the masked
+// layers are rendered unmasked from now on.
+func (d *LayeredDrawer) RemoveLayer(index int) {
+	d.layers[index].clear()
+	d.deleteLayer(index)
+}
+
+// RemoveLayerMigrate is like [RemoveLayer], but the graphics
+// are moved to the target layer instead of being disposed.
+//
+// The target index refers to the layers order before the removal.
+// The migrated graphics are drawn after the target layer graphics.
+// It panics if the removed layer has a custom implementation
+// that is not a [GraphicsInspector] (see [SetLayer]).
+func (d *LayeredDrawer) RemoveLayerMigrate(index, target int) {
+	if index == target {
+		panic("a layer can't be migrated to itself")
+	}
+	d.layers[target].addBatch(d.layers[index].liveGraphics())
+	d.deleteLayer(index)
+}
+
+func (d *LayeredDrawer) deleteLayer(index int) {
+	removed := d.layers[index]
+	d.layers = slices.Delete(d.layers, index, index+1)
+	for _, l := range d.layers {
+		if m, ok := l.mask.(*layerMask); ok && m.layer == removed {
+			l.setMask(nil)
+		}
+	}
+}
+
+// SetLayer replaces the layer implementation.
+//
+// This way, several layer kinds can be mixed inside one drawer:
+// a Y-sort layer, a batched particles layer, and a default layer.
+//
+// The graphics of the replaced layer implementation
+// are moved to the new one.
+// It panics if the replaced implementation is a custom layer
+// that is not a [GraphicsInspector], as its graphics can't be moved.
+// The layer mask and update interval are not affected.
+func (d *LayeredDrawer) SetLayer(index int, impl Layer) {
+	l := d.layers[index]
+	graphics := l.liveGraphics()
+	l.impl = impl
+	l.addBatch(graphics)
+	l.setAlpha(l.alpha)
+}
+
+// SetLayerSort makes the layer sort its graphics using the compare function
+// instead of the default [OrderedGraphics] draw order.
+// It's useful for the Y-sort and other ordering rules.
+//
+// The sorting is stable and it always starts from the addition order,
+// so the graphics that compare as equal are drawn in the order
+// they were added, regardless of the previous frames state.
+// The disposed graphics are removed before the sorting.
+// This makes the layer rendering deterministic (e.g. for the golden-image tests).
+//
+// Passing a nil function restores the default ordering.
+// It panics if the layer has a custom implementation (see [SetLayer]).
+func (d *LayeredDrawer) SetLayerSort(layer int, compare func(a, b Graphics) int) {
+	p, ok := d.layers[layer].impl.(*plainLayer)
+	if !ok {
+		panic("can't set a sort function for a custom layer")
+	}
+	p.compare = compare
+}
+
+// SetLayerMask makes the mask graphics a stencil for the specified layer.
+//
+// The masked layer contents are rendered only inside the mask shape:
+// every pixel of the layer is multiplied by the mask alpha.
+// This is useful for the fog of war, scoped views, portals, and UI clip regions.
+//
+// The mask is drawn with the same destination as the layer itself.
+// When the mask graphics is disposed, the layer is rendered unmasked again.
+// Passing a nil mask removes the current layer mask.
+func (d *LayeredDrawer) SetLayerMask(layer int, mask Graphics) {
+	d.layers[layer].setMask(mask)
+}
+
+// SetLayerMaskLayer is like [SetLayerMask], but another layer is used as a mask.
+//
+// The mask layer is not rendered on its own anymore,
+// its graphics are only used to mask the specified layer.
+// One mask layer can be shared between several masked layers.
+func (d *LayeredDrawer) SetLayerMaskLayer(layer, maskLayer int) {
+	if layer == maskLayer {
+		panic("a layer can't be used as its own mask")
+	}
+	m := d.layers[maskLayer]
+	m.isMask = true
+	d.SetLayerMask(layer, &layerMask{layer: m})
+}
+
+// SetLayerUpdateInterval makes the layer run its Update every n-th frame.
+//
+// The layer Update includes the disposed graphics filtering.
+// Static layers whose content changes rarely (backgrounds, decals)
+// can use a higher interval to reduce the per-frame overhead.
+// Note that disposed graphics of such layers may be drawn
+// for up to n-1 extra frames.
+//
+// The default interval is 1 (update every frame).
+func (d *LayeredDrawer) SetLayerUpdateInterval(layer, n int) {
+	if n <= 0 {
+		panic("layer update interval should be positive")
+	}
+	l := d.layers[layer]
+	l.updateInterval = n
+	l.updateCounter = 0
+}
+
+// AddGraphics implements the [Drawer] interface.
+func (d *LayeredDrawer) AddGraphics(g Graphics, layer int) {
+	d.layers[layer].add(g)
+}
+
+// AddGraphicsBatch implements the [BatchGraphicsAdder] interface.
+func (d *LayeredDrawer) AddGraphicsBatch(graphics []Graphics, layer int) {
+	d.layers[layer].addBatch(graphics)
+}
+
+// Update implements the [Drawer] interface.
+func (d *LayeredDrawer) Update(delta float64) {
+	for _, l := range d.layers {
+		if l.updateInterval > 1 {
+			l.updateCounter++
+			if l.updateCounter < l.updateInterval {
+				continue
+			}
+			l.updateCounter = 0
+		}
+		l.impl.Update(delta)
+	}
+}
+
+// SetInterpolationAlpha implements the [InterpolationAlphaSetter] interface.
+func (d *LayeredDrawer) SetInterpolationAlpha(alpha float64) {
+	for _, l := range d.layers {
+		l.setAlpha(alpha)
+	}
+}
+
+// ClearGraphics implements the [GraphicsClearer] interface.
+func (d *LayeredDrawer) ClearGraphics() {
+	for _, l := range d.layers {
+		l.clear()
+	}
+}
+
+// NumGraphics implements the [GraphicsCounter] interface.
+func (d *LayeredDrawer) NumGraphics() int {
+	n := 0
+	for _, l := range d.layers {
+		n += l.numGraphics()
+	}
+	return n
+}
+
+// EachGraphics implements the [GraphicsInspector] interface.
+func (d *LayeredDrawer) EachGraphics(fn func(g Graphics, layer int) bool) {
+	for i, l := range d.layers {
+		if !l.each(i, fn) {
+			return
+		}
+	}
+}
+
+// Draw implements the [Drawer] interface.
+func (d *LayeredDrawer) Draw(dst *ebiten.Image) {
+	d.draw(dst, nil)
+}
+
+// DrawTransformed implements the [TransformDrawer] interface.
+func (d *LayeredDrawer) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	d.draw(dst, &geom)
+}
+
+// DrawLayers implements the [LayersDrawer] interface.
+// The mask layers are never drawn on their own, even if they're listed.
+func (d *LayeredDrawer) DrawLayers(dst *ebiten.Image, geom ebiten.GeoM, layers []int) {
+	g := optionalGeoM(geom)
+	for _, i := range layers {
+		d.layers[i].draw(dst, g)
+	}
+}
+
+func (d *LayeredDrawer) draw(dst *ebiten.Image, geom *ebiten.GeoM) {
+	for _, l := range d.layers {
+		l.draw(dst, geom)
+	}
+}
+
+func (l *drawerLayer) draw(dst *ebiten.Image, geom *ebiten.GeoM) {
+	if l.isMask {
+		return
+	}
+	if l.mask != nil && l.mask.IsDisposed() {
+		l.setMask(nil)
+	}
+	if l.mask == nil {
+		l.drawContents(dst, geom)
+		return
+	}
+	l.drawMasked(dst, geom)
+}
+
+func (l *drawerLayer) setMask(mask Graphics) {
+	l.mask = mask
+	if mask == nil {
+		l.buf = nil
+		l.maskBuf = nil
+	}
+}
+
+func (l *drawerLayer) drawMasked(dst *ebiten.Image, geom *ebiten.GeoM) {
+	// The buffers cover the [0, dst.Max] area, so the graphics
+	// can use the same coordinates as they would for dst.
+	bounds := dst.Bounds()
+	l.buf = ensureBuffer(l.buf, bounds.Max.X, bounds.Max.Y)
+	l.maskBuf = ensureBuffer(l.maskBuf, bounds.Max.X, bounds.Max.Y)
+	l.buf.Clear()
+	l.maskBuf.Clear()
+
+	l.drawContents(l.buf, geom)
+	drawGraphics(l.maskBuf, l.mask, geom, l.alpha)
+
+	var maskOpts ebiten.DrawImageOptions
+	maskOpts.Blend = ebiten.BlendDestinationIn
+	l.buf.DrawImage(l.maskBuf, &maskOpts)
+
+	var opts ebiten.DrawImageOptions
+	opts.GeoM.Translate(float64(bounds.Min.X), float64(bounds.Min.Y))
+	dst.DrawImage(l.buf.SubImage(bounds).(*ebiten.Image), &opts)
+}
+
+func ensureBuffer(img *ebiten.Image, width, height int) *ebiten.Image {
+	if img != nil {
+		size := img.Bounds().Size()
+		if size.X == width && size.Y == height {
+			return img
+		}
+		img.Dispose()
+	}
+	return ebiten.NewImage(width, height)
+}
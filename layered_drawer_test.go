@@ -0,0 +1,164 @@
+package gscene_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/quasilyte/gscene"
+)
+
+func TestLayeredDrawerOrderAfterDispose(t *testing.T) {
+	var log []string
+	d := gscene.NewLayeredDrawer(2)
+	dst := ebiten.NewImage(8, 8)
+	draw := func() []string {
+		log = log[:0]
+		d.Update(1.0 / 60.0)
+		d.Draw(dst)
+		return log
+	}
+
+	graphics := map[string]*testGraphics{}
+	add := func(name string, layer int) {
+		g := &testGraphics{name: name, log: &log}
+		graphics[name] = g
+		d.AddGraphics(g, layer)
+	}
+	add("a", 1)
+	add("x", 0)
+	add("b", 1)
+	add("y", 0)
+	add("c", 1)
+	add("z", 0)
+	add("d", 1)
+
+	checkDrawLog(t, draw(), "x", "y", "z", "a", "b", "c", "d")
+
+	graphics["b"].Dispose()
+	graphics["y"].Dispose()
+	checkDrawLog(t, draw(), "x", "z", "a", "c", "d")
+
+	// The re-added graphics go to the end of their new layer.
+	graphics["b"].disposed = false
+	d.AddGraphics(graphics["b"], 0)
+	add("e", 1)
+	checkDrawLog(t, draw(), "x", "z", "b", "a", "c", "d", "e")
+
+	graphics["c"].Dispose()
+	graphics["x"].Dispose()
+	checkDrawLog(t, draw(), "z", "b", "a", "d", "e")
+}
+
+func TestLayeredDrawerOrderedGraphics(t *testing.T) {
+	var log []string
+	d := gscene.NewLayeredDrawer(2)
+	dst := ebiten.NewImage(8, 8)
+	draw := func() []string {
+		log = log[:0]
+		d.Update(1.0 / 60.0)
+		d.Draw(dst)
+		return log
+	}
+	add := func(name string, order, layer int) *orderedTestGraphics {
+		g := &orderedTestGraphics{testGraphics{name: name, log: &log, order: order}}
+		d.AddGraphics(g, layer)
+		return g
+	}
+
+	// The draw order only affects the graphics of the same layer.
+	a := add("a", 2, 0)
+	add("b", 1, 0)
+	c := add("c", 1, 0)
+	add("x", -5, 1)
+	checkDrawLog(t, draw(), "b", "c", "a", "x")
+
+	c.Dispose()
+	a.order = 1
+	add("d", 1, 0)
+	checkDrawLog(t, draw(), "a", "b", "d", "x")
+}
+
+func TestLayeredDrawerLayerSort(t *testing.T) {
+	var log []string
+	d := gscene.NewLayeredDrawer(1)
+	dst := ebiten.NewImage(8, 8)
+	draw := func() []string {
+		log = log[:0]
+		d.Update(1.0 / 60.0)
+		d.Draw(dst)
+		return log
+	}
+
+	// A Y-sort-like ordering: the order field plays the Y role.
+	d.SetLayerSort(0, func(a, b gscene.Graphics) int {
+		return cmp.Compare(a.(*testGraphics).order, b.(*testGraphics).order)
+	})
+
+	graphics := map[string]*testGraphics{}
+	add := func(name string, y int) {
+		g := &testGraphics{name: name, log: &log, order: y}
+		graphics[name] = g
+		d.AddGraphics(g, 0)
+	}
+	add("a", 30)
+	add("b", 10)
+	add("c", 20)
+	add("d", 10)
+	add("e", 20)
+	checkDrawLog(t, draw(), "b", "d", "c", "e", "a")
+
+	// Disposing a graphics in the middle doesn't
+	// affect the relative order of the others.
+	graphics["d"].Dispose()
+	graphics["c"].order = 10
+	checkDrawLog(t, draw(), "b", "c", "e", "a")
+
+	// The equal elements are ordered by their addition,
+	// regardless of the previous frame order.
+	graphics["b"].order = 20
+	checkDrawLog(t, draw(), "c", "b", "e", "a")
+
+	graphics["d"].disposed = false
+	d.AddGraphics(graphics["d"], 0)
+	checkDrawLog(t, draw(), "c", "d", "b", "e", "a")
+
+	// The nil function restores the addition order.
+	d.SetLayerSort(0, nil)
+	checkDrawLog(t, draw(), "a", "b", "c", "e", "d")
+}
+
+// opaqueLayer is a custom layer that can't report its graphics.
+type opaqueLayer struct {
+	graphics []gscene.Graphics
+}
+
+func (l *opaqueLayer) Add(g gscene.Graphics)  { l.graphics = append(l.graphics, g) }
+func (l *opaqueLayer) Update(delta float64)   {}
+func (l *opaqueLayer) Draw(dst *ebiten.Image) {}
+
+func TestLayeredDrawerOpaqueLayerMigration(t *testing.T) {
+	const want = "the layer doesn't implement GraphicsInspector"
+	checkPanic := func(name string, fn func()) {
+		t.Helper()
+		defer func() {
+			if rv := recover(); rv != want {
+				t.Fatalf("%s: have %v panic, want %q", name, rv, want)
+			}
+		}()
+		fn()
+	}
+
+	d := gscene.NewLayeredDrawer(2)
+	d.SetLayer(0, &opaqueLayer{})
+	d.AddGraphics(&testGraphics{name: "a"}, 0)
+	checkPanic("SetLayer", func() {
+		d.SetLayer(0, &opaqueLayer{})
+	})
+	checkPanic("RemoveLayerMigrate", func() {
+		d.RemoveLayerMigrate(0, 1)
+	})
+	if d.NumLayers() != 2 {
+		t.Fatalf("have %d layers after the failed migration, want 2", d.NumLayers())
+	}
+}
@@ -0,0 +1,117 @@
+package gscene
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+)
+
+// SceneLeak describes a disposed scene that is still reachable.
+// See [Manager.EnableLeakDetector].
+type SceneLeak struct {
+	// Controller is the leaked scene controller type name.
+	Controller string
+
+	// ObjectTypes maps the type names of the objects the scene
+	// had at the moment of its disposal to their counts.
+	// Some of them are likely to be the leak source.
+	ObjectTypes map[string]int
+}
+
+type leakDetector struct {
+	report  func(SceneLeak)
+	pending []*leakRecord
+}
+
+type leakRecord struct {
+	leak SceneLeak
+
+	// checks is a number of scene changes this record survived.
+	checks int
+
+	collected atomic.Bool
+}
+
+// leakSentinel is owned by the disposed scene.
+// It becomes unreachable together with the scene,
+// so its finalizer tells that the scene was collected.
+//
+// The scene itself can't have a finalizer as it's usually
+// a part of a reference cycle (scene -> controller -> scene)
+// and such cycles are never collected if they have a finalizer.
+type leakSentinel struct {
+	record *leakRecord
+}
+
+// EnableLeakDetector enables the disposed scenes tracking.
+//
+// Every scene that is disposed by a scene change is expected
+// to be garbage collected soon after that. If it's still
+// reachable after two more scene changes, the report callback
+// is called with the scene description.
+// The scene is usually kept alive by a forgotten global
+// reference to its object, controller, or the scene itself.
+//
+// Only the scene reachability is tracked. A retained object
+// is reported only if it keeps its scene alive (for example,
+// it stores the *Scene passed to its Init, like most objects do).
+// Objects that don't reference their scene (or its controller)
+// can leak unnoticed: the detector can't attach finalizers
+// to the user-defined objects as they may already have their own.
+//
+// Every scene change forces a garbage collection cycle while
+// the detector is enabled, so it's intended to be used in the
+// debug builds only.
+// Use the [EnableReferenceAudit] to find the exact leaking reference.
+func (m *Manager) EnableLeakDetector(report func(SceneLeak)) {
+	m.leakDetector = &leakDetector{report: report}
+}
+
+// check reports the tracked scenes that were not collected in time.
+func (d *leakDetector) check() {
+	if len(d.pending) == 0 {
+		return
+	}
+	// The finalizers are executed asynchronously after the GC,
+	// this is why a scene gets two checks before it's reported.
+	runtime.GC()
+	live := d.pending[:0]
+	for _, r := range d.pending {
+		if r.collected.Load() {
+			continue
+		}
+		r.checks++
+		if r.checks >= 2 {
+			d.report(r.leak)
+			continue
+		}
+		live = append(live, r)
+	}
+	clear(d.pending[len(live):])
+	d.pending = live
+}
+
+// track starts the scene reachability tracking.
+// It should be called right before the scene is disposed.
+//
+// The sentinel is tied to the scene only; see EnableLeakDetector
+// for the reasons why the objects are not tracked individually.
+func (d *leakDetector) track(s *Scene) {
+	r := &leakRecord{
+		leak: SceneLeak{
+			Controller:  fmt.Sprintf("%T", s.controllerImpl()),
+			ObjectTypes: make(map[string]int),
+		},
+	}
+	for _, list := range s.objectLists() {
+		for _, so := range list {
+			r.leak.ObjectTypes[fmt.Sprintf("%T", so.o)]++
+		}
+	}
+	sentinel := &leakSentinel{record: r}
+	runtime.SetFinalizer(sentinel, func(sentinel *leakSentinel) {
+		sentinel.record.collected.Store(true)
+	})
+	s.leakSentinel = sentinel
+	d.pending = append(d.pending, r)
+}
@@ -1,6 +1,9 @@
 package gscene
 
 import (
+	"image"
+	"time"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -14,10 +17,89 @@ import (
 type Manager struct {
 	currentScene *Scene
 	disposed     bool
+
+	timeScale float64
+	maxDelta  float64
+
+	deltaMode      DeltaMode
+	lastUpdateTime time.Time
+	clock          func() time.Time
+
+	rawDelta      float64
+	deltaSmoother *deltaSmoother
+
+	debugHotkeys *DebugHotkeysConfig
+
+	pendingLoad *sceneLoading
+
+	statsRecorder *statsRecorder
+
+	referenceAudit *referenceAudit
+
+	autosave *autosaveState
+
+	recycledBuffers *sceneBuffers
+
+	panicHandler PanicHandler
+
+	hooks [numHookEvents][]func(HookContext)
+
+	debugOverlay *debugOverlay
+	frameGraph   *frameGraph
+	inspector    *debugInspector
+
+	metricsSink   func(stats *FrameStats)
+	metricsByType bool
+
+	stepMode    bool
+	stepPending bool
+
+	headless bool
+
+	replay *replayState
+
+	tracer Tracer
+
+	inputFactory func(s *Scene) InputHandler
+
+	audioFadeOut  float64
+	fadingPlayers []fadingPlayer
+
+	powerSaving *powerSaving
+
+	frameSkip *frameSkip
+
+	pauseOnFocusLoss bool
+	focusLost        bool
+
+	// sceneStack holds the scenes below the current one.
+	// See [PushScene].
+	sceneStack []*Scene
+
+	middlewares []SceneMiddleware
+
+	lastDrawSize    image.Point
+	captureOnChange bool
+
+	warmUp bool
+
+	// insideDraw is set while the Draw tree is executed.
+	// The scene changes requested during that time are
+	// deferred until the next Update.
+	insideDraw          bool
+	pendingSceneChanges []func()
+
+	debugChecks  *debugChecker
+	leakDetector *leakDetector
+
+	paused       bool
+	pausedGroups uint64
 }
 
 func NewManager() *Manager {
-	return &Manager{}
+	return &Manager{
+		timeScale: 1,
+	}
 }
 
 // ChangeScene changes the current scene to a new one.
@@ -32,16 +114,177 @@ func NewManager() *Manager {
 //
 // The [Controller.Init] method of [c] will be called after
 // this new scene is installed.
+//
+// If ChangeScene is called from the Draw tree, the scene
+// can't be replaced right away. Such change is deferred until
+// the beginning of the next [UpdateWithDelta] call,
+// so the current scene finishes its Draw as usual.
+// The same rule applies to [PushScene] and [PopScene].
 func (m *Manager) ChangeScene(c Controller) {
+	m.ChangeSceneWithOptions(c, SceneOptions{})
+}
+
+// SceneOptions configures a scene created by [Manager.ChangeSceneWithOptions].
+type SceneOptions struct {
+	// Seed is used to initialize the scene-owned random source.
+	// See [Scene.Rand].
+	//
+	// A zero value means "use a random seed".
+	Seed int64
+
+	// Args is an arbitrary value passed to the controller Init.
+	// See [InitContext.Args].
+	Args any
+
+	// services are the initial scene services.
+	// See [Manager.ReloadScene].
+	services map[any]any
+}
+
+// ChangeSceneWithArgs is like [ChangeScene], but the args
+// are passed to the controller Init via [InitContext.Args].
+//
+// It's useful when the controllers are created by the scene registries
+// or factories, so their fields can't be set before the scene change.
+// See [ChangeSceneWith] for a type-safe alternative.
+func (m *Manager) ChangeSceneWithArgs(c Controller, args any) {
+	m.ChangeSceneWithOptions(c, SceneOptions{Args: args})
+}
+
+// ChangeSceneWithOptions is like [ChangeScene], but
+// the new scene is configured using the provided options.
+func (m *Manager) ChangeSceneWithOptions(c Controller, opts SceneOptions) {
+	if m.insideDraw {
+		m.deferSceneChange(func() { m.ChangeSceneWithOptions(c, opts) })
+		return
+	}
+	// An explicit scene change cancels the scene loading.
+	m.pendingLoad = nil
+	m.changeScene(c, opts)
+}
+
+// deferSceneChange queues a scene change requested from the Draw tree.
+// The scene can't be disposed during the Draw, so the change
+// is applied at the beginning of the next Update.
+func (m *Manager) deferSceneChange(fn func()) {
+	m.pendingSceneChanges = append(m.pendingSceneChanges, fn)
+}
+
+func (m *Manager) applySceneChanges() {
+	changes := m.pendingSceneChanges
+	m.pendingSceneChanges = nil
+	for _, fn := range changes {
+		fn()
+	}
+}
+
+func (m *Manager) changeScene(c Controller, opts SceneOptions) {
 	prevScene := m.currentScene
+	stackedScenes := m.sceneStack
+	m.sceneStack = nil
 
-	m.currentScene = newScene(c)
-	m.currentScene.drawer = newSimpleDrawer()
-	c.Init(InitContext{Scene: m.currentScene})
+	if m.replay != nil {
+		opts = m.replaySceneOptions(opts, true)
+	}
+	s := m.newScene(c, opts)
+	if m.captureOnChange && prevScene != nil {
+		s.prevFrame = m.CaptureFrame()
+		if s.prevFrame != nil {
+			s.OwnResource(s.prevFrame)
+		}
+	}
+	m.startScene(s, prevScene)
 
 	if prevScene != nil {
-		prevScene.dispose()
+		m.disposeScenes(append(stackedScenes, prevScene), prevScene)
+	}
+}
+
+// newScene creates a scene configured according to the manager settings.
+func (m *Manager) newScene(c Controller, opts SceneOptions) *Scene {
+	s := newScene(c, opts)
+	if m.headless {
+		s.drawer = headlessDrawer{}
+		s.headless = true
+	} else {
+		s.drawer = newSimpleDrawer()
+	}
+	if m.recycledBuffers != nil {
+		m.recycledBuffers.apply(s)
+		m.recycledBuffers = nil
 	}
+	s.panicHandler = m.panicHandler
+	s.tracer = m.tracer
+	s.inputFactory = m.inputFactory
+	s.debugChecks = m.debugChecks
+	s.managerPausedGroups = m.pausedGroups
+	return s
+}
+
+// startScene makes the scene current and initializes its controller.
+func (m *Manager) startScene(s, prevScene *Scene) {
+	m.currentScene = s
+	m.syncStatsEnabled()
+	m.syncSceneStack()
+	if m.tracer != nil {
+		m.tracer.OnSceneChanged(prevScene, s)
+	}
+	m.initScene(s, prevScene)
+	if m.warmUp && !s.disposed {
+		m.warmUpScene(s)
+	}
+	if m.focusLost && m.currentScene == s {
+		m.syncSceneFocus(s)
+	}
+}
+
+// disposeScenes releases the scenes that are not used anymore.
+// The buffers of the recycle scene (if not nil) are reused by the next new scene.
+func (m *Manager) disposeScenes(scenes []*Scene, recycle *Scene) {
+	// The audit needs the objects lists, so it goes
+	// before the buffers are taken and the scenes are disposed.
+	if m.referenceAudit != nil {
+		for _, s := range scenes {
+			m.referenceAudit.run(s)
+		}
+	}
+	if recycle != nil {
+		m.recycledBuffers = takeSceneBuffers(recycle)
+	}
+	if m.leakDetector != nil {
+		m.leakDetector.check()
+		for _, s := range scenes {
+			m.leakDetector.track(s)
+		}
+	}
+
+	// If one of the scenes is being updated right now,
+	// its dispose aborts the Update, so it goes last.
+	var active *Scene
+	for _, s := range scenes {
+		if s.insideUpdate {
+			active = s
+			continue
+		}
+		m.releaseAudio(s)
+		s.dispose()
+	}
+	if active != nil {
+		m.releaseAudio(active)
+		active.dispose()
+	}
+}
+
+// ChangeSceneWith is like [Manager.ChangeScene], but it also passes
+// the typed data to the controller's Init method.
+//
+// This is a type-safe way to pass the level numbers, loadouts,
+// and results to the next scene.
+//
+// Note that [Scene.Controller] returns an adapter object
+// for the scenes created this way.
+func ChangeSceneWith[T any](m *Manager, c ControllerWithData[T], data T) {
+	m.ChangeScene(&dataController[T]{c: c, data: data})
 }
 
 func (m *Manager) CurrentScene() *Scene {
@@ -56,9 +299,93 @@ func (m *Manager) Dispose() {
 	m.disposed = true
 }
 
-// Update is a shorthand for [UpdateWithDelta](1.0/60.0).
+// SetTimeScale changes the simulation speed multiplier.
+//
+// Every delta passed to the scene is multiplied by this value.
+// A value of 0.5 makes the game run two times slower,
+// while 2 makes it run two times faster.
+//
+// The default time scale is 1.
+func (m *Manager) SetTimeScale(scale float64) {
+	if scale < 0 {
+		panic("time scale can't be negative")
+	}
+	m.timeScale = scale
+}
+
+// TimeScale returns the current simulation speed multiplier.
+// See [SetTimeScale].
+func (m *Manager) TimeScale() float64 {
+	return m.timeScale
+}
+
+// SetMaxDelta limits the delta passed to the scene.
+//
+// A long GC pause or a window drag can produce a single giant delta
+// that teleports the objects or triggers a lot of catch-up
+// fixed steps (the "spiral of death").
+// With this limit, the game simply slows down during such frames.
+//
+// The limit is applied before the time scale.
+// A zero value disables the limit, this is the default.
+func (m *Manager) SetMaxDelta(maxDelta float64) {
+	m.maxDelta = maxDelta
+}
+
+// DeltaMode specifies how [Manager.Update] computes the frame delta.
+type DeltaMode int
+
+const (
+	// DeltaFixed makes Update assume a fixed 1/60 delta.
+	// This is a default mode.
+	DeltaFixed DeltaMode = iota
+
+	// DeltaRealTime makes Update measure the time elapsed
+	// since its previous call and use it as a delta.
+	DeltaRealTime
+)
+
+// SetDeltaMode changes the way [Update] computes the frame delta.
+//
+// The default fixed mode is only correct when the game runs
+// with the default ebiten TPS (60).
+// Games that change the TPS should either use the real time mode
+// or call [UpdateWithDelta] with the appropriate delta.
+func (m *Manager) SetDeltaMode(mode DeltaMode) {
+	m.deltaMode = mode
+	m.lastUpdateTime = time.Time{}
+}
+
+// SetClock replaces the time source used by the [DeltaRealTime] mode.
+//
+// It's mostly useful for the tests that need a controllable clock.
+// A nil function restores the default time.Now clock.
+func (m *Manager) SetClock(now func() time.Time) {
+	m.clock = now
+	m.lastUpdateTime = time.Time{}
+}
+
+func (m *Manager) now() time.Time {
+	if m.clock != nil {
+		return m.clock()
+	}
+	return time.Now()
+}
+
+// Update calls [UpdateWithDelta] with the delta computed
+// according to the current delta mode (see [SetDeltaMode]).
+//
+// By default, it's a shorthand for [UpdateWithDelta](1.0/60.0).
 func (m *Manager) Update() {
-	m.currentScene.update()
+	delta := 1.0 / 60.0
+	if m.deltaMode == DeltaRealTime {
+		now := m.now()
+		if !m.lastUpdateTime.IsZero() {
+			delta = now.Sub(m.lastUpdateTime).Seconds()
+		}
+		m.lastUpdateTime = now
+	}
+	m.UpdateWithDelta(delta)
 }
 
 // UpdateWithDelta calls the Update methods on the entire scene tree.
@@ -70,9 +397,128 @@ func (m *Manager) Update() {
 //
 // Disposed object are removed from the objects list.
 func (m *Manager) UpdateWithDelta(delta float64) {
+	if m.debugHotkeys != nil {
+		m.handleDebugHotkeys()
+	}
+	if m.powerSaving != nil {
+		m.stepPowerSaving()
+	}
+	// The changes requested from the Draw tree are applied even
+	// if this Update call doesn't run a frame (focus pause, step mode);
+	// otherwise they would wait for an unpredictable amount of time.
+	if m.pendingSceneChanges != nil {
+		m.applySceneChanges()
+	}
+	if m.pauseOnFocusLoss && m.handleFocus() {
+		return
+	}
+	if m.stepModeFrozen() {
+		return
+	}
+	// A requested step is exactly one frame, so the
+	// frame skipping is not applied in the step mode.
+	if m.frameSkip != nil && !m.stepMode {
+		for i := m.frameSkip.numUpdates(); i > 0; i-- {
+			m.runFrame(delta)
+		}
+		return
+	}
+	m.runFrame(delta)
+}
+
+func (m *Manager) runFrame(delta float64) {
+	m.rawDelta = delta
+	replaying := m.IsReplaying()
+	if m.deltaSmoother != nil && !replaying {
+		delta = m.deltaSmoother.Filter(delta)
+	}
+	if m.replay != nil {
+		delta = m.stepReplay(delta)
+	}
+
+	m.runHooks(BeforeUpdate, HookContext{Delta: delta})
+
+	m.updateScene(delta, replaying)
+
+	if m.autosave != nil {
+		m.stepAutosave(delta)
+	}
+
+	if len(m.fadingPlayers) != 0 {
+		m.stepAudioFade(delta)
+	}
+
+	if m.pendingLoad != nil {
+		m.stepSceneLoading()
+	}
+
+	m.runHooks(AfterUpdate, HookContext{Delta: delta})
+}
+
+// UpdateE is like [Update], but it also returns the fatal error
+// reported via [Scene.Fail] (if any).
+//
+// It's intended to be used as a [ebiten.Game] Update implementation:
+//
+//	func (g *myGame) Update() error {
+//		return g.sceneManager.UpdateE()
+//	}
+func (m *Manager) UpdateE() error {
+	if err := m.currentScene.Err(); err != nil {
+		return err
+	}
+	m.Update()
+	return m.currentScene.Err()
+}
+
+// UpdateWithDeltaE is like [UpdateE], but for [UpdateWithDelta].
+func (m *Manager) UpdateWithDeltaE(delta float64) error {
+	if err := m.currentScene.Err(); err != nil {
+		return err
+	}
+	m.UpdateWithDelta(delta)
+	return m.currentScene.Err()
+}
+
+// updateScene runs the scene tree Update.
+// The replayed deltas are already clamped and scaled,
+// so they're applied as is.
+func (m *Manager) updateScene(delta float64, replayed bool) {
+	if !replayed {
+		if m.maxDelta > 0 && delta > m.maxDelta {
+			delta = m.maxDelta
+		}
+		delta *= m.timeScale
+	}
+	if m.replay != nil && m.replay.recording {
+		m.recordReplayFrame(delta)
+	}
+	if len(m.sceneStack) != 0 && !m.updateStackedScenes(delta) {
+		return
+	}
 	m.currentScene.updateWithDelta(delta)
 }
 
+// RunHeadless runs the given number of update frames as fast as possible.
+//
+// Only the Update tree is executed: there are no Draw calls,
+// so nothing is rendered and the frame pacing is ignored.
+// It's useful for the balancing scripts and AI-vs-AI batch runs
+// that need to simulate a lot of frames using the same scene code.
+//
+// Every frame is executed like [UpdateWithDelta] would do it,
+// except for the debug hotkeys that are not handled here.
+// If the scene is changed during the run, the remaining frames
+// are executed for the new scene.
+func (m *Manager) RunHeadless(frames int, delta float64) {
+	if m.pendingSceneChanges != nil {
+		m.applySceneChanges()
+	}
+	for i := 0; i < frames; i++ {
+		m.runFrame(delta)
+	}
+}
+
 // Draw calls the Draw methods on the entire scene tree.
 //
 // It calls the Draw methods on scene graphics that are not disposed.
@@ -80,5 +526,33 @@ func (m *Manager) UpdateWithDelta(delta float64) {
 //
 // Disposed graphics are removed from the objects list.
 func (m *Manager) Draw(dst *ebiten.Image) {
+	if m.currentScene.headless {
+		return
+	}
+	m.lastDrawSize = dst.Bounds().Size()
+	if m.frameSkip != nil {
+		m.frameSkip.onDraw(m.now())
+	}
+	m.insideDraw = true
+	m.runHooks(BeforeDraw, HookContext{Dst: dst})
+	for _, s := range m.sceneStack {
+		s.draw(dst)
+	}
 	m.currentScene.draw(dst)
+
+	if m.statsEnabled() {
+		m.finishFrameStats()
+	}
+	if m.debugOverlay != nil {
+		m.debugOverlay.draw(dst)
+	}
+	if m.frameGraph != nil {
+		m.frameGraph.draw(dst)
+	}
+	if m.inspector != nil {
+		m.inspector.draw(dst, m.currentScene)
+	}
+
+	m.runHooks(AfterDraw, HookContext{Dst: dst})
+	m.insideDraw = false
 }
@@ -0,0 +1,66 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ManagerGroup runs several scene managers as a single unit.
+//
+// The update logic is shared: every Update call updates
+// all managers in their registration order.
+// The rendering is separate: every manager is drawn into
+// its own destination image that is supplied per Draw call.
+//
+// This is useful for the tool-style applications that render
+// the game and a debug view into different windows or render targets.
+type ManagerGroup struct {
+	managers []*Manager
+}
+
+// NewManagerGroup creates a group of the given managers.
+// The managers index in the group is the same as in the arguments list.
+func NewManagerGroup(managers ...*Manager) *ManagerGroup {
+	return &ManagerGroup{managers: managers}
+}
+
+// Manager returns the group member by its index.
+func (g *ManagerGroup) Manager(i int) *Manager {
+	return g.managers[i]
+}
+
+// NumManagers reports the number of the group members.
+func (g *ManagerGroup) NumManagers() int {
+	return len(g.managers)
+}
+
+// Update calls [Manager.Update] for every group member.
+//
+// Every manager computes its own delta according to its [DeltaMode].
+func (g *ManagerGroup) Update() {
+	for _, m := range g.managers {
+		m.Update()
+	}
+}
+
+// UpdateWithDelta calls [Manager.UpdateWithDelta] for every group member.
+func (g *ManagerGroup) UpdateWithDelta(delta float64) {
+	for _, m := range g.managers {
+		m.UpdateWithDelta(delta)
+	}
+}
+
+// Draw renders every group member into its own destination image.
+//
+// The i-th manager is drawn into the dst[i] image.
+// Managers with a nil (or missing) destination are not drawn.
+func (g *ManagerGroup) Draw(dst ...*ebiten.Image) {
+	for i, m := range g.managers {
+		if i >= len(dst) {
+			break
+		}
+		if dst[i] == nil {
+			continue
+		}
+		m.Draw(dst[i])
+	}
+}
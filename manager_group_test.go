@@ -0,0 +1,39 @@
+package gscene_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestManagerGroupUpdate(t *testing.T) {
+	clock := gscenetest.NewClock()
+	var deltas [2][]float64
+	managers := make([]*gscene.Manager, 2)
+	for i := range managers {
+		i := i
+		managers[i] = gscenetest.NewManager(&gscenetest.Controller{
+			Recorder: &gscenetest.Recorder{},
+			OnUpdate: func(delta float64) {
+				deltas[i] = append(deltas[i], delta)
+			},
+		})
+	}
+	// Only the second manager measures the real time.
+	managers[1].SetClock(clock.Now)
+	managers[1].SetDeltaMode(gscene.DeltaRealTime)
+
+	g := gscene.NewManagerGroup(managers...)
+	g.Update()
+	clock.Advance(50 * time.Millisecond)
+	g.Update()
+
+	if deltas[0][0] != 1.0/60.0 || deltas[0][1] != 1.0/60.0 {
+		t.Fatalf("fixed delta manager: have %v", deltas[0])
+	}
+	if deltas[1][0] != 1.0/60.0 || deltas[1][1] != 0.05 {
+		t.Fatalf("real time manager: have %v", deltas[1])
+	}
+}
@@ -0,0 +1,39 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// drawHook is a graphics that runs a function from the Draw tree.
+type drawHook struct {
+	fn func()
+}
+
+func (g *drawHook) Draw(dst *ebiten.Image) { g.fn() }
+func (g *drawHook) IsDisposed() bool       { return false }
+
+func TestDeferredSceneChangeInStepMode(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscene.NewManager()
+	m.ChangeScene(&gscenetest.Controller{
+		Recorder: r,
+		OnInit: func(ctx gscene.InitContext) {
+			ctx.Scene.AddGraphics(&drawHook{fn: func() {
+				m.ChangeScene(&gscenetest.Controller{Recorder: r, Name: "next"})
+			}}, 0)
+		},
+	})
+	m.SetStepMode(true)
+
+	// The scene change requested from the Draw is applied
+	// by the next Update, even if it doesn't run a frame.
+	m.Draw(ebiten.NewImage(8, 8))
+	m.UpdateWithDelta(1.0 / 60.0)
+	if err := r.Check("controller.Init", "next.Init"); err != nil {
+		t.Fatal(err)
+	}
+}
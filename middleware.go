@@ -0,0 +1,64 @@
+package gscene
+
+// SceneChange describes a scene change handled by the [SceneMiddleware].
+type SceneChange struct {
+	Manager *Manager
+
+	// Prev is the scene being replaced.
+	// It's nil for the first scene.
+	//
+	// When the scene is replaced (rather than pushed),
+	// it's disposed after all middlewares are executed.
+	Prev *Scene
+
+	// Next is the scene being installed.
+	// Its controller is initialized by the innermost next call.
+	Next *Scene
+}
+
+// SceneMiddleware observes or wraps the scene changes.
+// See [Manager.Use].
+//
+// The middleware must call next exactly once,
+// before it returns; otherwise the manager panics.
+// The code before the next call is executed before
+// the new scene controller Init, the code after it
+// is executed when the new scene is already initialized.
+type SceneMiddleware func(change SceneChange, next func())
+
+// Use registers a scene change middleware.
+//
+// Middlewares run in the registration order around the
+// [Controller.Init] of every new scene (including the pushed ones).
+// They're useful for analytics, auto-saving, asset prefetching,
+// and injecting the scene transitions.
+func (m *Manager) Use(mw SceneMiddleware) {
+	m.middlewares = append(m.middlewares, mw)
+}
+
+func (m *Manager) initScene(s, prevScene *Scene) {
+	if len(m.middlewares) == 0 {
+		s.controllerObject.Init(s.initContext())
+		return
+	}
+	change := SceneChange{Manager: m, Prev: prevScene, Next: s}
+	m.runMiddleware(0, change)
+}
+
+func (m *Manager) runMiddleware(i int, change SceneChange) {
+	if i == len(m.middlewares) {
+		change.Next.controllerObject.Init(change.Next.initContext())
+		return
+	}
+	called := false
+	m.middlewares[i](change, func() {
+		if called {
+			panic("scene middleware called next more than once")
+		}
+		called = true
+		m.runMiddleware(i+1, change)
+	})
+	if !called {
+		panic("scene middleware returned without calling next")
+	}
+}
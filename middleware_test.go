@@ -0,0 +1,46 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestMiddlewareNextCalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		numCalls int
+		want     any
+	}{
+		{"once", 1, nil},
+		{"never", 0, "scene middleware returned without calling next"},
+		{"twice", 2, "scene middleware called next more than once"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &gscenetest.Recorder{}
+			m := gscene.NewManager()
+			m.SetHeadless(true)
+			m.Use(func(change gscene.SceneChange, next func()) {
+				for i := 0; i < test.numCalls; i++ {
+					next()
+				}
+			})
+			defer func() {
+				if rv := recover(); rv != test.want {
+					t.Fatalf("have %v panic, want %v", rv, test.want)
+				}
+				// The second next call doesn't initialize the scene again.
+				var events []string
+				if test.numCalls != 0 {
+					events = append(events, "controller.Init")
+				}
+				if err := r.Check(events...); err != nil {
+					t.Fatal(err)
+				}
+			}()
+			m.ChangeScene(&gscenetest.Controller{Recorder: r})
+		})
+	}
+}
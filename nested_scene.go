@@ -0,0 +1,161 @@
+package gscene
+
+import (
+	"image"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// NestedSceneConfig is an argument type for [NewNestedScene].
+type NestedSceneConfig struct {
+	// Controller initializes and runs the inner scene.
+	Controller Controller
+
+	// Bounds is the outer destination area the inner scene
+	// is rendered into. The inner scene is rendered into
+	// its own image of this size, so the inner graphics (0, 0)
+	// is mapped to the Bounds.Min point.
+	Bounds image.Rectangle
+
+	// Layer is an outer scene layer for the inner scene graphics.
+	Layer int
+
+	// Options configure the inner scene.
+	Options SceneOptions
+}
+
+// NestedScene runs a scene inside another scene.
+//
+// The inner scene has its own controller, objects, and drawer.
+// It's added to the outer scene as an [Object] and [Graphics] pair:
+// it's updated during the outer objects Update and it's
+// rendered into a sub-area of the outer destination.
+//
+// It's useful for the in-game arcade machines, picture-in-picture
+// cutscenes, and editors.
+type NestedScene struct {
+	config NestedSceneConfig
+	outer  *Scene
+	inner  *Scene
+	buf    *ebiten.Image
+
+	disposed bool
+}
+
+// NewNestedScene creates a nested scene adapter.
+// Add it to the outer scene using the [Scene.AddObject];
+// the inner scene controller Init is called at that moment.
+//
+// The inner scene is disposed together with the outer scene,
+// so there is no need to dispose it manually before the scene change.
+// Removing the adapter via [Scene.RemoveObject] disposes it as well.
+func NewNestedScene(config NestedSceneConfig) *NestedScene {
+	return &NestedScene{config: config}
+}
+
+// Scene returns the inner scene.
+// It's nil until the adapter is added to the outer scene.
+func (n *NestedScene) Scene() *Scene {
+	return n.inner
+}
+
+// SetBounds changes the outer destination area of the inner scene.
+func (n *NestedScene) SetBounds(bounds image.Rectangle) {
+	n.config.Bounds = bounds
+}
+
+// Init implements the [Object] interface.
+func (n *NestedScene) Init(outer *Scene) {
+	inner := newScene(n.config.Controller, n.config.Options)
+	if outer.headless {
+		inner.drawer = headlessDrawer{}
+		inner.headless = true
+	} else {
+		inner.drawer = newSimpleDrawer()
+	}
+	inner.panicHandler = outer.panicHandler
+	inner.tracer = outer.tracer
+	inner.inputFactory = outer.inputFactory
+	inner.debugChecks = outer.debugChecks
+	n.outer = outer
+	n.inner = inner
+	outer.nested = append(outer.nested, n)
+
+	outer.AddGraphics(n, n.config.Layer)
+	inner.controllerObject.Init(inner.initContext())
+}
+
+// IsDisposed implements the [Object] and [Graphics] interfaces.
+func (n *NestedScene) IsDisposed() bool {
+	return n.disposed
+}
+
+// Dispose removes the nested scene from the outer scene
+// and disposes the inner scene.
+//
+// Just like [Manager.ChangeScene], it aborts the
+// inner scene Update if it's called from there.
+func (n *NestedScene) Dispose() {
+	if n.disposed {
+		return
+	}
+	n.disposed = true
+	if n.buf != nil {
+		n.buf.Dispose()
+		n.buf = nil
+	}
+	if n.outer != nil && !n.outer.disposed {
+		if i := slices.Index(n.outer.nested, n); i != -1 {
+			n.outer.nested = slices.Delete(n.outer.nested, i, i+1)
+		}
+	}
+	if n.inner != nil {
+		n.inner.dispose()
+	}
+}
+
+// OnRemovedFromScene implements the [RemovalObserver] interface.
+// The adapter removed via [Scene.RemoveObject] disposes the inner scene.
+func (n *NestedScene) OnRemovedFromScene(s *Scene) {
+	n.Dispose()
+}
+
+// disposeWithOuter is called when the outer scene is disposed.
+// The inner Update (if any) is aborted by the outer scene,
+// so the inner dispose should not abort it on its own.
+func (n *NestedScene) disposeWithOuter() {
+	if n.inner != nil {
+		n.inner.insideUpdate = false
+	}
+	n.Dispose()
+}
+
+// Update implements the [Object] interface.
+func (n *NestedScene) Update(delta float64) {
+	n.inner.updateWithDelta(delta)
+	if !n.outer.insideUpdate {
+		// The outer scene Update was aborted from inside of
+		// the inner scene (the inner scene caught the stop signal),
+		// so the abort is continued here.
+		panic(stopUpdate)
+	}
+}
+
+// Draw implements the [Graphics] interface.
+func (n *NestedScene) Draw(dst *ebiten.Image) {
+	size := n.config.Bounds.Size()
+	if size.X <= 0 || size.Y <= 0 {
+		return
+	}
+	n.buf = ensureBuffer(n.buf, size.X, size.Y)
+	n.buf.Clear()
+	n.inner.insideDraw = true
+	n.inner.drawGraphics(n.buf)
+	n.inner.insideDraw = false
+
+	pos := n.config.Bounds.Min.Add(dst.Bounds().Min)
+	var opts ebiten.DrawImageOptions
+	opts.GeoM.Translate(float64(pos.X), float64(pos.Y))
+	dst.DrawImage(n.buf, &opts)
+}
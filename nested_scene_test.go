@@ -0,0 +1,117 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// resourceCounter counts its Dispose calls.
+type resourceCounter struct {
+	numDisposed int
+}
+
+func (r *resourceCounter) Dispose() { r.numDisposed++ }
+
+func TestNestedSceneDisposedWithOuter(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	outer := m.CurrentScene()
+
+	res := &resourceCounter{}
+	inner := gscene.NewNestedScene(gscene.NestedSceneConfig{
+		Controller: &gscenetest.Controller{
+			Recorder: r,
+			Name:     "inner",
+			OnInit: func(ctx gscene.InitContext) {
+				ctx.Scene.OwnResource(res)
+			},
+		},
+	})
+	outer.AddObject(inner)
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	m.ChangeScene(&gscenetest.Controller{Recorder: r, Name: "next"})
+	if !inner.IsDisposed() {
+		t.Fatal("the nested scene is not disposed with its outer scene")
+	}
+	if res.numDisposed != 1 {
+		t.Fatalf("the inner scene resource is disposed %d times", res.numDisposed)
+	}
+
+	// The explicit Dispose after the scene change is a no-op.
+	inner.Dispose()
+	if res.numDisposed != 1 {
+		t.Fatalf("the inner scene resource is disposed %d times", res.numDisposed)
+	}
+}
+
+func TestNestedSceneChangeFromInner(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	outer := m.CurrentScene()
+
+	innerController := &gscenetest.Controller{Recorder: r, Name: "inner"}
+	outer.AddObject(gscene.NewNestedScene(gscene.NestedSceneConfig{
+		Controller: innerController,
+	}))
+	outer.AddObject(&gscenetest.Object{Recorder: r, Name: "sibling"})
+	if err := gscenetest.RunFrames(m, 1, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The scene change aborts the outer Update tree as well,
+	// so the objects after the nested scene are not updated.
+	innerController.OnUpdate = func(delta float64) {
+		m.ChangeScene(&gscenetest.Controller{Recorder: r, Name: "next"})
+	}
+	r.Reset()
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	err := r.Check(
+		"controller.Update", "inner.Update", "next.Init",
+		"next.Update",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNestedSceneRemoved(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	outer := m.CurrentScene()
+
+	res := &resourceCounter{}
+	inner := gscene.NewNestedScene(gscene.NestedSceneConfig{
+		Controller: &gscenetest.Controller{
+			Recorder: r,
+			Name:     "inner",
+			OnInit: func(ctx gscene.InitContext) {
+				ctx.Scene.OwnResource(res)
+			},
+		},
+	})
+	outer.AddObject(inner)
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	outer.RemoveObject(inner)
+	if err := gscenetest.RunFrames(m, 1, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if !inner.IsDisposed() || res.numDisposed != 1 {
+		t.Fatalf("the removed nested scene is not disposed (%d resource disposals)", res.numDisposed)
+	}
+
+	// The outer scene doesn't dispose the unlinked inner scene again.
+	m.ChangeScene(&gscenetest.Controller{Recorder: r, Name: "next"})
+	if res.numDisposed != 1 {
+		t.Fatalf("the inner scene resource is disposed %d times", res.numDisposed)
+	}
+}
@@ -0,0 +1,54 @@
+package gscene
+
+// PanicAction tells the scene what to do with the object
+// whose Update has panicked.
+// See [Manager.SetPanicHandler].
+type PanicAction int
+
+const (
+	// PanicRemoveObject removes the object from the scene
+	// and continues the execution.
+	PanicRemoveObject PanicAction = iota
+
+	// PanicRethrow re-panics with the original value.
+	PanicRethrow
+)
+
+// PanicHandler is called when the object Update panics.
+// It receives the offending object and the recovered panic value.
+type PanicHandler func(o Object, v any) PanicAction
+
+// SetPanicHandler installs the per-object panic recovery policy.
+//
+// With the handler installed, a panic raised inside a single
+// [Object.Update] is recovered and reported to the handler.
+// Then the object is either removed from the scene or
+// the panic is re-raised, depending on the returned action.
+// For the shipped games, one buggy particle shouldn't crash the whole session.
+//
+// The scene changes and [Scene.Fail] calls are never intercepted.
+// A nil handler disables the recovery, this is the default.
+func (m *Manager) SetPanicHandler(h PanicHandler) {
+	m.panicHandler = h
+	if m.currentScene != nil {
+		m.currentScene.panicHandler = h
+	}
+	for _, s := range m.sceneStack {
+		s.panicHandler = h
+	}
+}
+
+func (s *Scene) safeObjectUpdate(o Object, delta float64) {
+	defer func() {
+		rv := recover()
+		if rv == nil {
+			return
+		}
+		if rv == stopUpdate || s.panicHandler(o, rv) == PanicRethrow {
+			panic(rv)
+		}
+		s.RemoveObject(o)
+	}()
+
+	o.Update(delta)
+}
@@ -0,0 +1,34 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestPanicHandlerStackedScene(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	o := &gscenetest.Object{
+		Recorder: r,
+		OnUpdate: func(delta float64) {
+			panic("object panic")
+		},
+	}
+	m.CurrentScene().AddObject(o)
+	m.PushScene(&gscenetest.Controller{Recorder: r, Name: "top"}, false)
+
+	// The handler installed after the push covers the scene below.
+	var failed []gscene.Object
+	m.SetPanicHandler(func(o gscene.Object, v any) gscene.PanicAction {
+		failed = append(failed, o)
+		return gscene.PanicRemoveObject
+	})
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 1 || failed[0] != o {
+		t.Fatalf("have %v objects reported, want the stacked scene object", failed)
+	}
+}
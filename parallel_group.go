@@ -0,0 +1,226 @@
+package gscene
+
+import (
+	"slices"
+	"sync"
+)
+
+// ParallelGroup is an [Object] that updates its members concurrently.
+//
+// The group members Update calls are distributed across the workers
+// within one frame. The group itself is updated at its position
+// in the scene objects list, so the members are updated
+// before the objects that were added after the group.
+//
+// It's intended for the particle simulations and thousands
+// of trivially-parallel entities. The member Update methods should be
+// independent: they must not modify the shared state, including the scene
+// (no AddObject or AddGraphics calls from the Update).
+// Member Init is called sequentially when the member is added.
+//
+// Only the Update method of the members is called;
+// the optional interfaces (like [PostUpdater]) are not supported for them.
+type ParallelGroup struct {
+	scene   *Scene
+	workers int
+	members []Object
+	added   []Object
+
+	// jobs feeds the persistent worker goroutines.
+	// They're started by the first Update and stopped by Dispose.
+	jobs chan parallelJob
+	wg   sync.WaitGroup
+
+	mu     sync.Mutex
+	panics []memberPanic
+
+	disposed bool
+}
+
+type parallelJob struct {
+	start   int
+	members []Object
+	delta   float64
+}
+
+// memberPanic is a panic recovered inside of a worker.
+// It's re-raised (or reported to the panic handler)
+// on the goroutine that runs the group Update.
+type memberPanic struct {
+	index int
+	o     Object
+	v     any
+}
+
+// NewParallelGroup creates a group that uses the specified number
+// of workers for the updates.
+// Add the group to the scene via [Scene.AddObject] before adding the members.
+//
+// The worker goroutines are kept alive between the frames.
+// They're stopped when the group is disposed (either explicitly
+// or together with its scene) or removed via [Scene.RemoveObject].
+func NewParallelGroup(workers int) *ParallelGroup {
+	if workers <= 0 {
+		panic("a parallel group needs at least one worker")
+	}
+	return &ParallelGroup{workers: workers}
+}
+
+// Init implements the [Object] interface.
+func (g *ParallelGroup) Init(s *Scene) {
+	g.scene = s
+	s.OwnResource(g)
+}
+
+// IsDisposed implements the [Object] interface.
+func (g *ParallelGroup) IsDisposed() bool {
+	return g.disposed
+}
+
+// OnRemovedFromScene implements the [RemovalObserver] interface.
+// A group removed from its scene is disposed, so its workers are stopped.
+func (g *ParallelGroup) OnRemovedFromScene(s *Scene) {
+	g.Dispose()
+}
+
+// Dispose marks the group as disposed and stops its workers.
+// All its members are discarded together with the group.
+func (g *ParallelGroup) Dispose() {
+	if g.disposed {
+		return
+	}
+	g.disposed = true
+	if g.jobs != nil {
+		close(g.jobs)
+		g.jobs = nil
+	}
+}
+
+// Add adds the object to the group.
+// Its [Object.Init] method will be called right away.
+//
+// Like with [Scene.AddObject], the object starts receiving
+// the Update calls from the next frame.
+func (g *ParallelGroup) Add(o Object) {
+	if g.scene.tracer != nil {
+		g.scene.tracer.OnObjectAdded(g.scene, o)
+	}
+	g.added = append(g.added, o)
+	o.Init(g.scene)
+}
+
+// Len reports the number of group members.
+func (g *ParallelGroup) Len() int {
+	return len(g.members) + len(g.added)
+}
+
+// Update implements the [Object] interface.
+//
+// The members of the paused update groups are skipped
+// (see [Scene.SetGroupPaused]).
+//
+// A panic inside of the member Update is recovered by the worker
+// and then re-raised by this method, after all workers are done.
+// If the scene has a panic handler (see [Manager.SetPanicHandler]),
+// it's called for the offending member instead;
+// the [PanicRemoveObject] action removes the member from the group.
+func (g *ParallelGroup) Update(delta float64) {
+	if g.disposed {
+		return
+	}
+
+	liveMembers := g.members[:0]
+	for _, o := range g.members {
+		if o.IsDisposed() {
+			g.traceRemoved(o)
+			continue
+		}
+		liveMembers = append(liveMembers, o)
+	}
+	clear(g.members[len(liveMembers):])
+	g.members = liveMembers
+
+	if n := len(g.members); n != 0 {
+		if g.jobs == nil {
+			g.startWorkers()
+		}
+		chunkSize := (n + g.workers - 1) / g.workers
+		for start := 0; start < n; start += chunkSize {
+			g.wg.Add(1)
+			g.jobs <- parallelJob{
+				start:   start,
+				members: g.members[start:min(start+chunkSize, n)],
+				delta:   delta,
+			}
+		}
+		g.wg.Wait()
+		if len(g.panics) != 0 {
+			g.handlePanics()
+		}
+	}
+
+	g.members = append(g.members, g.added...)
+	clear(g.added)
+	g.added = g.added[:0]
+}
+
+func (g *ParallelGroup) startWorkers() {
+	g.jobs = make(chan parallelJob, g.workers)
+	for i := 0; i < g.workers; i++ {
+		go g.work(g.jobs)
+	}
+}
+
+func (g *ParallelGroup) work(jobs <-chan parallelJob) {
+	for job := range jobs {
+		for i, o := range job.members {
+			g.updateMember(job.start+i, o, job.delta)
+		}
+		g.wg.Done()
+	}
+}
+
+func (g *ParallelGroup) updateMember(index int, o Object, delta float64) {
+	defer func() {
+		rv := recover()
+		if rv == nil {
+			return
+		}
+		g.mu.Lock()
+		g.panics = append(g.panics, memberPanic{index: index, o: o, v: rv})
+		g.mu.Unlock()
+	}()
+
+	if g.scene.isObjectPaused(o) {
+		return
+	}
+	o.Update(delta)
+}
+
+// handlePanics processes the recovered member panics
+// on the calling goroutine, in the members order.
+func (g *ParallelGroup) handlePanics() {
+	panics := g.panics
+	g.panics = nil
+	slices.SortFunc(panics, func(a, b memberPanic) int {
+		return a.index - b.index
+	})
+	for _, p := range panics {
+		if p.v == stopUpdate || g.scene.panicHandler == nil {
+			panic(p.v)
+		}
+		if g.scene.panicHandler(p.o, p.v) == PanicRethrow {
+			panic(p.v)
+		}
+		if i := slices.Index(g.members, p.o); i != -1 {
+			g.members = slices.Delete(g.members, i, i+1)
+			g.traceRemoved(p.o)
+		}
+	}
+}
+
+func (g *ParallelGroup) traceRemoved(o Object) {
+	if g.scene.tracer != nil {
+		g.scene.tracer.OnObjectRemoved(g.scene, o)
+	}
+}
@@ -0,0 +1,126 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// parallelMember counts its updates.
+// The counters are only read after the group Update is finished.
+type parallelMember struct {
+	group      gscene.UpdateGroup
+	numUpdates int
+	panicValue any
+	disposed   bool
+}
+
+func (o *parallelMember) Init(scene *gscene.Scene)        {}
+func (o *parallelMember) IsDisposed() bool                { return o.disposed }
+func (o *parallelMember) UpdateGroup() gscene.UpdateGroup { return o.group }
+
+func (o *parallelMember) Update(delta float64) {
+	if o.panicValue != nil {
+		panic(o.panicValue)
+	}
+	o.numUpdates++
+}
+
+func TestParallelGroupPausedMembers(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	g := gscene.NewParallelGroup(3)
+	m.CurrentScene().AddObject(g)
+	members := make([]*parallelMember, 10)
+	for i := range members {
+		members[i] = &parallelMember{group: gscene.UpdateGroup(i % 2)}
+		g.Add(members[i])
+	}
+	// The first frame adds the group, the second one adds its members.
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	m.CurrentScene().SetGroupPaused(1, true)
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	for i, o := range members {
+		want := 2
+		if o.group == 1 {
+			want = 0
+		}
+		if o.numUpdates != want {
+			t.Fatalf("member %d: have %d updates, want %d", i, o.numUpdates, want)
+		}
+	}
+}
+
+func TestParallelGroupPanic(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	g := gscene.NewParallelGroup(3)
+	m.CurrentScene().AddObject(g)
+	members := make([]*parallelMember, 10)
+	for i := range members {
+		members[i] = &parallelMember{group: gscene.UpdateGroup(i % 2)}
+		g.Add(members[i])
+	}
+	// The first frame adds the group, the second one adds its members.
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	members[4].panicValue = "member panic"
+	func() {
+		defer func() {
+			if rv := recover(); rv != "member panic" {
+				t.Fatalf("have %v panic, want the member panic", rv)
+			}
+		}()
+		m.UpdateWithDelta(1.0 / 60.0)
+	}()
+
+	var failed []gscene.Object
+	m.SetPanicHandler(func(o gscene.Object, v any) gscene.PanicAction {
+		failed = append(failed, o)
+		return gscene.PanicRemoveObject
+	})
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 1 || failed[0] != members[4] {
+		t.Fatalf("have %v objects reported, want the member 4", failed)
+	}
+	for i, o := range members {
+		if i != 4 && o.numUpdates != 3 {
+			t.Fatalf("member %d: have %d updates, want 3", i, o.numUpdates)
+		}
+	}
+}
+
+func TestParallelGroupRemoved(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	g := gscene.NewParallelGroup(3)
+	m.CurrentScene().AddObject(g)
+	o := &parallelMember{}
+	g.Add(o)
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The group is removed at the end of the next Update cycle.
+	m.CurrentScene().RemoveObject(g)
+	if err := gscenetest.RunFrames(m, 1, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if !g.IsDisposed() {
+		t.Fatal("the removed group is not disposed")
+	}
+	numUpdates := o.numUpdates
+	if err := gscenetest.RunFrames(m, 2, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if o.numUpdates != numUpdates {
+		t.Fatal("the removed group member is still updated")
+	}
+}
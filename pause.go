@@ -0,0 +1,43 @@
+package gscene
+
+// SetPaused pauses or resumes the entire simulation.
+//
+// While paused, the scene objects are not updated,
+// except for the objects from the exempt update groups
+// (pause menu UI, background music visualizer, etc.).
+// This affects Update, FixedUpdate and PostUpdate calls,
+// just like [Scene.SetGroupPaused] does.
+// The scene controllers are never paused, the graphics are still drawn.
+//
+// Unlike the scene group pausing, this setting belongs to the manager:
+// it affects all scenes, including the ones created later.
+// The groups paused by the scene itself stay paused
+// even if they're exempt here.
+//
+// Every call replaces the previous exempt groups list.
+func (m *Manager) SetPaused(paused bool, except ...UpdateGroup) {
+	var mask uint64
+	if paused {
+		mask = ^uint64(0)
+		for _, group := range except {
+			if group >= 64 {
+				panic("update group value is out of range")
+			}
+			mask &^= 1 << group
+		}
+	}
+	m.pausedGroups = mask
+	m.paused = paused
+	if m.currentScene != nil {
+		m.currentScene.managerPausedGroups = mask
+	}
+	for _, s := range m.sceneStack {
+		s.managerPausedGroups = mask
+	}
+}
+
+// IsPaused reports whether the simulation is paused.
+// See [SetPaused].
+func (m *Manager) IsPaused() bool {
+	return m.paused
+}
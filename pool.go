@@ -0,0 +1,60 @@
+package gscene
+
+// Pool is a recycling storage for the scene objects of type T.
+//
+// Objects that are added to the scene via [AddToScene] are returned
+// to the pool when the scene removes them (after they report being disposed).
+// The next [Acquire] call will re-use such an object instead of allocating
+// a new one. This is useful for bullet-hell and particle-heavy games
+// that need to avoid per-frame allocations.
+//
+// A recycled object is returned as is, it's up to the caller
+// to reset its state before adding it to the scene again.
+// Note that [Object.Init] is called for the recycled object once again
+// as a part of the [Scene.AddObject] protocol.
+type Pool[T Object] struct {
+	free  []T
+	alloc func() T
+}
+
+// objectReleaser is implemented by the [Pool] type.
+// The scene uses it to return the removed objects to their pools.
+type objectReleaser interface {
+	release(o Object)
+}
+
+// NewPool creates an empty pool that uses alloc to create
+// the new objects when there are no free objects to re-use.
+func NewPool[T Object](alloc func() T) *Pool[T] {
+	return &Pool[T]{
+		free:  make([]T, 0, 16),
+		alloc: alloc,
+	}
+}
+
+// Acquire returns a recycled object or allocates a new one.
+func (p *Pool[T]) Acquire() T {
+	if len(p.free) == 0 {
+		return p.alloc()
+	}
+	o := p.free[len(p.free)-1]
+	var zero T
+	p.free[len(p.free)-1] = zero
+	p.free = p.free[:len(p.free)-1]
+	return o
+}
+
+// AddToScene is like [Scene.AddObject], but the object
+// is returned to this pool when the scene removes it.
+func (p *Pool[T]) AddToScene(s *Scene, o T) {
+	s.addObject(o, p)
+}
+
+// NumFree reports the number of objects that are ready to be re-used.
+func (p *Pool[T]) NumFree() int {
+	return len(p.free)
+}
+
+func (p *Pool[T]) release(o Object) {
+	p.free = append(p.free, o.(T))
+}
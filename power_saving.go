@@ -0,0 +1,116 @@
+package gscene
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// PowerSavingConfig is an argument type for [Manager.EnablePowerSaving].
+type PowerSavingConfig struct {
+	// UnfocusedTPS is a TPS that is used while the window has no focus.
+	// It's only relevant if the game is runnable on unfocused
+	// (see [ebiten.SetRunnableOnUnfocused]).
+	//
+	// A zero value means "don't change the TPS on focus loss".
+	UnfocusedTPS int
+
+	// IdleTPS is a TPS that is used after there was
+	// no user input for IdleAfter seconds.
+	//
+	// A zero value means "don't change the TPS when idle".
+	IdleTPS int
+
+	// IdleAfter is a number of seconds without any user input
+	// that makes the game idle.
+	IdleAfter float64
+}
+
+type powerSaving struct {
+	config    PowerSavingConfig
+	normalTPS int
+
+	lastInput  time.Time
+	cursorPos  [2]int
+	gamepadIDs []ebiten.GamepadID
+	buf        []ebiten.GamepadButton
+}
+
+// EnablePowerSaving makes the manager lower the TPS
+// when the window loses focus or the game is idle.
+// The TPS is restored as soon as the window gets the focus back
+// or there is some user input.
+//
+// The current TPS is treated as the normal one,
+// so the game should not change the TPS while this mode is active.
+//
+// Since the TPS is changed, the [DeltaRealTime] mode
+// should be used to get the correct deltas (see [SetDeltaMode]).
+func (m *Manager) EnablePowerSaving(config PowerSavingConfig) {
+	m.DisablePowerSaving()
+	m.powerSaving = &powerSaving{
+		config:    config,
+		normalTPS: ebiten.TPS(),
+		lastInput: m.now(),
+	}
+}
+
+// DisablePowerSaving turns off the mode enabled by [EnablePowerSaving]
+// and restores the normal TPS.
+func (m *Manager) DisablePowerSaving() {
+	if m.powerSaving == nil {
+		return
+	}
+	ebiten.SetTPS(m.powerSaving.normalTPS)
+	m.powerSaving = nil
+}
+
+func (m *Manager) stepPowerSaving() {
+	p := m.powerSaving
+	now := m.now()
+	if p.hasInput() {
+		p.lastInput = now
+	}
+
+	tps := p.normalTPS
+	switch {
+	case p.config.UnfocusedTPS != 0 && !ebiten.IsFocused():
+		tps = p.config.UnfocusedTPS
+	case p.config.IdleTPS != 0 && now.Sub(p.lastInput).Seconds() >= p.config.IdleAfter:
+		tps = p.config.IdleTPS
+	}
+	if ebiten.TPS() != tps {
+		ebiten.SetTPS(tps)
+	}
+}
+
+func (p *powerSaving) hasInput() bool {
+	x, y := ebiten.CursorPosition()
+	if p.cursorPos != [2]int{x, y} {
+		p.cursorPos = [2]int{x, y}
+		return true
+	}
+	if wx, wy := ebiten.Wheel(); wx != 0 || wy != 0 {
+		return true
+	}
+	if len(inpututil.AppendPressedKeys(nil)) != 0 {
+		return true
+	}
+	for b := ebiten.MouseButton0; b <= ebiten.MouseButtonMax; b++ {
+		if ebiten.IsMouseButtonPressed(b) {
+			return true
+		}
+	}
+	if len(ebiten.AppendTouchIDs(nil)) != 0 {
+		return true
+	}
+	p.gamepadIDs = ebiten.AppendGamepadIDs(p.gamepadIDs[:0])
+	for _, id := range p.gamepadIDs {
+		p.buf = inpututil.AppendPressedGamepadButtons(id, p.buf[:0])
+		if len(p.buf) != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package gscene
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Platform is a target platform kind used by the [Registry].
+type Platform int
+
+const (
+	PlatformDesktop Platform = iota
+	PlatformMobile
+	PlatformWeb
+)
+
+// CurrentPlatform reports the platform the game is running on.
+// It's detected using the build target OS.
+func CurrentPlatform() Platform {
+	switch runtime.GOOS {
+	case "android", "ios":
+		return PlatformMobile
+	case "js", "wasip1":
+		return PlatformWeb
+	default:
+		return PlatformDesktop
+	}
+}
+
+// Registry maps names to the factories of type T.
+//
+// It's intended to be used for the named scene controllers
+// (Registry[Controller]) and object prefabs (Registry[Object]).
+//
+// Every name can have a platform-specific factory,
+// so the game code can request the same name everywhere
+// while getting, for example, a touch UI scene on mobiles
+// and a desktop UI scene on PC.
+type Registry[T any] struct {
+	platform Platform
+	entries  map[string]*registryEntry[T]
+}
+
+type registryEntry[T any] struct {
+	fallback   func() T
+	byPlatform map[Platform]func() T
+}
+
+// NewRegistry creates an empty registry bound to [CurrentPlatform].
+func NewRegistry[T any]() *Registry[T] {
+	return &Registry[T]{
+		platform: CurrentPlatform(),
+		entries:  make(map[string]*registryEntry[T]),
+	}
+}
+
+// SetPlatform overrides the registry platform.
+// It's useful to test the platform-specific scenes on a desktop.
+func (r *Registry[T]) SetPlatform(p Platform) {
+	r.platform = p
+}
+
+// Register binds the platform-independent factory to the name.
+// This factory is used if there is no platform-specific factory.
+func (r *Registry[T]) Register(name string, factory func() T) {
+	r.getEntry(name).fallback = factory
+}
+
+// RegisterForPlatform binds the platform-specific factory to the name.
+func (r *Registry[T]) RegisterForPlatform(name string, p Platform, factory func() T) {
+	e := r.getEntry(name)
+	if e.byPlatform == nil {
+		e.byPlatform = make(map[Platform]func() T, 2)
+	}
+	e.byPlatform[p] = factory
+}
+
+// New creates a new value using the factory bound to the name.
+//
+// The factory for the registry platform is preferred.
+// It panics if there is no suitable factory.
+func (r *Registry[T]) New(name string) T {
+	e := r.entries[name]
+	if e != nil {
+		if f, ok := e.byPlatform[r.platform]; ok {
+			return f()
+		}
+		if e.fallback != nil {
+			return e.fallback()
+		}
+	}
+	panic(fmt.Sprintf("no factory is registered for %q", name))
+}
+
+func (r *Registry[T]) getEntry(name string) *registryEntry[T] {
+	e := r.entries[name]
+	if e == nil {
+		e = &registryEntry[T]{}
+		r.entries[name] = e
+	}
+	return e
+}
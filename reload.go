@@ -0,0 +1,42 @@
+package gscene
+
+// ReloadScene replaces the current scene with a fresh one
+// that is initialized by the same controller.
+//
+// It's a development tool: tweaking the layout or spawn code
+// (or the data files it reads) doesn't require navigating
+// back to the scene every time.
+//
+// The controller Init is called once again, so it should
+// be prepared to be re-initialized. The new scene uses
+// the same random seed and init args as the current one.
+//
+// The keep arguments are the service keys (see [Scene.SetService])
+// that are moved to the new scene before the controller Init.
+// For the services registered via [SetServiceOf],
+// the key is reflect.TypeFor[T]().
+// Keys that are not registered are ignored.
+//
+// Just like with [ChangeScene], the current scene is disposed
+// along with the stacked scenes.
+func (m *Manager) ReloadScene(keep ...any) {
+	if m.insideDraw {
+		m.deferSceneChange(func() { m.ReloadScene(keep...) })
+		return
+	}
+	s := m.currentScene
+	if s == nil {
+		panic("there is no scene to reload")
+	}
+	opts := SceneOptions{Seed: s.seed, Args: s.args}
+	if len(keep) != 0 {
+		opts.services = make(map[any]any, len(keep))
+		for _, key := range keep {
+			if v, ok := s.services[key]; ok {
+				opts.services[key] = v
+			}
+		}
+	}
+	m.pendingLoad = nil
+	m.changeScene(s.controllerObject, opts)
+}
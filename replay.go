@@ -0,0 +1,215 @@
+package gscene
+
+import (
+	"time"
+)
+
+// Replay is a recorded sequence of the update frames.
+// See [Manager.StartReplayRecording].
+//
+// It can be serialized using encoding/json or encoding/gob.
+type Replay struct {
+	// Seed is the random seed of the scene the recording started with.
+	// See [SceneOptions.Seed].
+	Seed int64 `json:"seed"`
+
+	// SceneSeeds are the random seeds of the scenes created
+	// during the recording (by [Manager.ChangeScene] and [Manager.PushScene]),
+	// in the creation order.
+	// The playback creates these scenes using the same seeds.
+	SceneSeeds []int64 `json:"scene_seeds,omitempty"`
+
+	Frames []ReplayFrame `json:"frames"`
+}
+
+// ReplayFrame is a single recorded update frame.
+type ReplayFrame struct {
+	// Delta is the delta that was applied to the scene,
+	// after the max delta clamping and the time scale.
+	Delta float64 `json:"delta"`
+
+	// Input is an input snapshot returned by the recording callback.
+	Input []byte `json:"input,omitempty"`
+}
+
+type replayState struct {
+	replay *Replay
+
+	// pending is set until the replay reaches a scene boundary.
+	pending bool
+
+	recording bool
+	capture   func() []byte
+	input     []byte
+
+	apply func(input []byte)
+	pos   int
+
+	// scene is the next SceneSeeds index to be used by the playback.
+	scene int
+}
+
+// StartReplayRecording makes the manager record every update frame
+// until [StopReplayRecording] is called.
+//
+// The capture function is called before every frame Update tree
+// execution; its result is stored alongside the frame delta.
+// It should return the game-specific input snapshot for this frame.
+// A nil capture function means that only the deltas are recorded.
+//
+// The scene random source state can't be captured mid-scene,
+// so the recording starts at a scene boundary:
+// right away if the current scene wasn't updated yet,
+// otherwise with the next scene change:
+//
+//	m.StartReplayRecording(captureInput)
+//	m.ChangeScene(c)
+//
+// The scenes created after that are recorded as well:
+// their seeds are stored in [Replay.SceneSeeds].
+//
+// Any active replay playback is stopped.
+func (m *Manager) StartReplayRecording(capture func() []byte) {
+	m.replay = &replayState{
+		replay:    &Replay{},
+		recording: true,
+		capture:   capture,
+		pending:   true,
+	}
+	if s := m.currentScene; s != nil && s.tick == 0 {
+		m.replay.replay.Seed = s.seed
+		m.replay.pending = false
+	}
+}
+
+// StopReplayRecording stops the recording and returns the recorded replay.
+// It returns nil if there is no recording in progress.
+func (m *Manager) StopReplayRecording() *Replay {
+	if m.replay == nil || !m.replay.recording {
+		return nil
+	}
+	r := m.replay.replay
+	m.replay = nil
+	return r
+}
+
+// StartReplayPlayback makes the manager replay the recorded frames.
+//
+// During the playback, the deltas passed to [UpdateWithDelta]
+// are replaced by the recorded ones (the delta smoothing,
+// max delta and time scale are not applied: the recorded deltas
+// already include them).
+// The apply function is called with the recorded input snapshot
+// before every frame Update tree execution, so the game can
+// feed it to its input system instead of the real input.
+//
+// Just like the recording, the playback starts at a scene boundary.
+// If the current scene wasn't updated yet and it uses the replay seed,
+// the playback starts right away. Otherwise it starts with the next
+// scene change, and that scene is created using the replay seed:
+//
+//	m.StartReplayPlayback(replay, applyInput)
+//	m.ChangeScene(c)
+//
+// The playback stops automatically after the last recorded frame.
+// Any active replay recording is stopped.
+func (m *Manager) StartReplayPlayback(r *Replay, apply func(input []byte)) {
+	m.replay = nil
+	if len(r.Frames) == 0 {
+		return
+	}
+	m.replay = &replayState{
+		replay:  r,
+		apply:   apply,
+		pending: true,
+	}
+	if s := m.currentScene; s != nil && s.tick == 0 && s.seed == r.Seed {
+		m.replay.pending = false
+	}
+}
+
+// StopReplayPlayback stops the playback started by [StartReplayPlayback].
+func (m *Manager) StopReplayPlayback() {
+	if m.replay != nil && !m.replay.recording {
+		m.replay = nil
+	}
+}
+
+// IsReplaying reports whether a replay playback is in progress.
+// A playback that waits for the scene change is not in progress yet.
+func (m *Manager) IsReplaying() bool {
+	return m.replay != nil && !m.replay.recording && !m.replay.pending
+}
+
+// replaySceneOptions returns the options the new scene should be created with.
+//
+// A pending replay starts with the scene change (a pushed scene
+// is not a boundary, as the scenes below it are not recreated).
+// After that, every new scene seed is recorded or replayed.
+func (m *Manager) replaySceneOptions(opts SceneOptions, sceneChange bool) SceneOptions {
+	state := m.replay
+	if state.pending && !sceneChange {
+		return opts
+	}
+	first := state.pending
+	state.pending = false
+
+	if state.recording {
+		if opts.Seed == 0 {
+			opts.Seed = time.Now().UnixNano()
+		}
+		if first {
+			state.replay.Seed = opts.Seed
+		} else {
+			state.replay.SceneSeeds = append(state.replay.SceneSeeds, opts.Seed)
+		}
+		return opts
+	}
+
+	if first {
+		opts.Seed = state.replay.Seed
+	} else if state.scene < len(state.replay.SceneSeeds) {
+		opts.Seed = state.replay.SceneSeeds[state.scene]
+		state.scene++
+	}
+	return opts
+}
+
+// stepReplay records or replays the current frame input.
+// It returns the delta that should be used for this frame.
+func (m *Manager) stepReplay(delta float64) float64 {
+	state := m.replay
+	if state.pending {
+		return delta
+	}
+	if state.recording {
+		if state.capture != nil {
+			state.input = state.capture()
+		}
+		return delta
+	}
+
+	frame := state.replay.Frames[state.pos]
+	state.pos++
+	if state.pos == len(state.replay.Frames) {
+		m.replay = nil
+	}
+	if state.apply != nil {
+		state.apply(frame.Input)
+	}
+	return frame.Delta
+}
+
+// recordReplayFrame stores the frame with the delta
+// that is about to be applied to the scene.
+func (m *Manager) recordReplayFrame(delta float64) {
+	state := m.replay
+	if state.pending {
+		return
+	}
+	state.replay.Frames = append(state.replay.Frames, ReplayFrame{
+		Delta: delta,
+		Input: state.input,
+	})
+	state.input = nil
+}
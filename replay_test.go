@@ -0,0 +1,111 @@
+package gscene_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestReplay(t *testing.T) {
+	// The controller logs the applied deltas and the scene random values.
+	var log []string
+	var m *gscene.Manager
+	c := &gscenetest.Controller{
+		Recorder: &gscenetest.Recorder{},
+		OnUpdate: func(delta float64) {
+			log = append(log, fmt.Sprintf("%v:%d", delta, m.CurrentScene().Rand().Intn(1000)))
+		},
+	}
+
+	// The recording started mid-scene waits for the scene change.
+	m = gscenetest.NewManager(c)
+	if err := gscenetest.RunFrames(m, 3, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	m.StartReplayRecording(nil)
+	if err := gscenetest.RunFrames(m, 3, 0.25); err != nil {
+		t.Fatal(err)
+	}
+
+	// The recorded deltas include the clamping and the time scale.
+	log = nil
+	m.SetTimeScale(2)
+	m.SetMaxDelta(0.25)
+	m.ChangeScene(c)
+	for _, delta := range []float64{0.125, 0.5, 0.25, 0.0625} {
+		if err := gscenetest.RunFrames(m, 1, delta); err != nil {
+			t.Fatal(err)
+		}
+	}
+	replay := m.StopReplayRecording()
+	recorded := log
+	wantDeltas := []float64{0.25, 0.5, 0.5, 0.125}
+	if len(replay.Frames) != len(wantDeltas) {
+		t.Fatalf("have %d frames recorded, want %d", len(replay.Frames), len(wantDeltas))
+	}
+	for i, f := range replay.Frames {
+		if f.Delta != wantDeltas[i] {
+			t.Fatalf("frame %d: have %v delta recorded, want %v", i, f.Delta, wantDeltas[i])
+		}
+	}
+
+	// The playback doesn't depend on the current manager settings.
+	log = nil
+	m.SetTimeScale(1)
+	m.StartReplayPlayback(replay, nil)
+	m.ChangeScene(c)
+	if err := gscenetest.RunFrames(m, 4, 1); err != nil {
+		t.Fatal(err)
+	}
+	if m.IsReplaying() {
+		t.Fatal("the playback is not stopped after the last frame")
+	}
+	if fmt.Sprint(log) != fmt.Sprint(recorded) {
+		t.Fatalf("playback diverged:\nrecorded: %v\nplayed:   %v", recorded, log)
+	}
+}
+
+func TestReplaySceneChange(t *testing.T) {
+	// The controller changes the scene on the second update
+	// and pushes a scene on the fourth one.
+	var log []string
+	var m *gscene.Manager
+	var c *gscenetest.Controller
+	c = &gscenetest.Controller{
+		Recorder: &gscenetest.Recorder{},
+		OnUpdate: func(delta float64) {
+			log = append(log, fmt.Sprint(m.CurrentScene().Rand().Intn(1000)))
+			switch len(log) {
+			case 2:
+				m.ChangeScene(c)
+			case 4:
+				m.PushScene(c, false)
+			}
+		},
+	}
+
+	m = gscene.NewManager()
+	m.SetHeadless(true)
+	m.StartReplayRecording(nil)
+	m.ChangeScene(c)
+	if err := gscenetest.RunFrames(m, 6, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	replay := m.StopReplayRecording()
+	recorded := log
+	if len(replay.SceneSeeds) != 2 {
+		t.Fatalf("have %d scene seeds recorded, want 2", len(replay.SceneSeeds))
+	}
+
+	log = nil
+	m.StartReplayPlayback(replay, nil)
+	m.ChangeScene(c)
+	if err := gscenetest.RunFrames(m, 6, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(log) != fmt.Sprint(recorded) {
+		t.Fatalf("playback diverged:\nrecorded: %v\nplayed:   %v", recorded, log)
+	}
+}
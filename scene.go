@@ -1,6 +1,12 @@
 package gscene
 
 import (
+	"image/color"
+	"math/rand"
+	"reflect"
+	"slices"
+	"time"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -15,12 +21,132 @@ import (
 // Therefore, you should avoid the unnecessary global state whether possible.
 type Scene struct {
 	controllerObject Controller
+	subControllers   []SubController
 	drawer           Drawer
 
-	objects      []Object
-	addedObjects []Object
+	objects         []sceneObject
+	addedObjects    []sceneObject
+	removedObjects  []sceneObject
+	objectsToRemove []Object
+	deferredObjects []Object
+	deferredCalls   []func()
+	postUpdaters    []postUpdaterObject
+	compaction      objectsCompaction
+
+	phaseHandlers [numUpdatePhases][]phaseHandler
+
+	systems      []*updateSystem
+	systemsOrder []*updateSystem
+
+	timers []sceneTimer
+
+	commands       []any
+	commandHandler CommandHandler
+
+	services map[any]any
+
+	schedule     eventQueue
+	tickSchedule eventQueue
+	scheduleSeq  uint64
+
+	budgeted         []budgetedEntry
+	budgetCursor     int
+	updateBudget     time.Duration
+	onBudgetExceeded func(spent time.Duration)
+
+	// numAddedToClear is a number of add-queue objects
+	// that should be removed by a pending ClearObjects call.
+	// A negative value means there is no pending clear.
+	numAddedToClear int
+
+	fixedUpdaters []fixedUpdaterObject
+	fixedDelta    float64
+	fixedAccum    float64
+
+	time float64
+	tick uint64
+
+	tasks      []*TaskHandle
+	taskBudget time.Duration
 
 	insideUpdate bool
+
+	err          error
+	panicHandler PanicHandler
+	tracer       Tracer
+
+	pausedGroups uint64
+
+	// managerPausedGroups is controlled by the [Manager.SetPaused].
+	managerPausedGroups uint64
+
+	unorderedRemoval bool
+
+	throttleSeq int
+
+	debugGizmos bool
+	watches     []sceneWatch
+
+	headless bool
+
+	clearColor color.Color
+
+	prevFrame *ebiten.Image
+	freeze    *freezeFrame
+
+	debugChecks  *debugChecker
+	leakSentinel *leakSentinel
+	insideDraw   bool
+	disposed     bool
+
+	modal        bool
+	topmost      bool
+	inputBlocked bool
+
+	// focusLost is the last focus state reported to the controller.
+	focusLost bool
+
+	resources    []interface{ Dispose() }
+	nested       []*NestedScene
+	audioPlayers []AudioPlayer
+
+	input        InputHandler
+	inputFactory func(s *Scene) InputHandler
+
+	seed int64
+	rand *rand.Rand
+
+	args any
+
+	statsEnabled       bool
+	profileObjectTypes bool
+	stats              FrameStats
+}
+
+// sceneObject is an object list element.
+// It holds the object itself and its scene-related metadata.
+type sceneObject struct {
+	o Object
+
+	// pool is not nil for the objects added via [Pool.AddToScene].
+	pool objectReleaser
+
+	// Throttled objects are updated every interval-th frame.
+	// The counter is initialized with a phase value,
+	// so throttled objects are spread across the frames.
+	interval int
+	counter  int
+	accum    float64
+}
+
+// objectsCompaction describes the in-place objects filtering state.
+//
+// Elements in [numLive, next) range are stale while
+// the compaction is active.
+type objectsCompaction struct {
+	active  bool
+	numLive int
+	next    int
 }
 
 type stopUpdateType struct{}
@@ -31,19 +157,76 @@ var stopUpdate any = &stopUpdateType{}
 //
 // It's the caller's responsibility to call [Controller.Init]
 // with the created scene object.
-func newScene(c Controller) *Scene {
+func newScene(c Controller, opts SceneOptions) *Scene {
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
 	scene := &Scene{
+		seed:             seed,
 		controllerObject: c,
-		objects:          make([]Object, 0, 32),
-		addedObjects:     make([]Object, 0, 8),
+		objects:          make([]sceneObject, 0, 32),
+		addedObjects:     make([]sceneObject, 0, 8),
+		fixedDelta:       1.0 / 60.0,
+		numAddedToClear:  -1,
+		taskBudget:       4 * time.Millisecond,
+		services:         opts.services,
+		args:             opts.Args,
 	}
 	return scene
 }
 
+func (s *Scene) initContext() InitContext {
+	return InitContext{Scene: s, PrevFrame: s.prevFrame, Args: s.args}
+}
+
 func (s *Scene) Controller() Controller {
 	return s.controllerObject
 }
 
+// Time returns the scene time in seconds.
+//
+// It's a sum of all deltas the scene was updated with.
+// Since the deltas are already scaled by the manager time scale,
+// the scene time respects it as well.
+// The time doesn't advance while the scene is not updated (e.g. paused).
+func (s *Scene) Time() float64 {
+	return s.time
+}
+
+// Tick returns the number of Update cycles executed by this scene.
+func (s *Scene) Tick() uint64 {
+	return s.tick
+}
+
+// Rand returns the scene-owned random source.
+//
+// It's seeded using the [SceneOptions.Seed] value, so the gameplay
+// randomness can be reproduced by re-creating a scene with the same seed.
+// This is useful for the demos and replays.
+func (s *Scene) Rand() *rand.Rand {
+	if s.rand == nil {
+		s.rand = rand.New(rand.NewSource(s.seed))
+	}
+	return s.rand
+}
+
+// Seed returns the seed of the scene random source.
+// If a random seed was used, its actual value is reported.
+func (s *Scene) Seed() int64 {
+	return s.seed
+}
+
+// controllerImpl returns the user-provided controller object.
+// It's used to check whether the controller implements
+// some optional interface.
+func (s *Scene) controllerImpl() any {
+	if w, ok := s.controllerObject.(wrappedController); ok {
+		return w.unwrap()
+	}
+	return s.controllerObject
+}
+
 // AddObject adds the logical object to the scene.
 // Its [Object.Init] method will be called right away.
 //
@@ -59,16 +242,454 @@ func (s *Scene) Controller() Controller {
 // they can be easily garbage-collected as soon as this scene
 // will be garbage-collected (there is usually only 1 active scene at a time).
 func (s *Scene) AddObject(o Object) {
-	s.addedObjects = append(s.addedObjects, o)
+	s.addObject(o, nil)
+}
+
+// AddObjectDeferred is like [AddObject], but the object
+// Init is not called right away.
+//
+// Instead, the object is initialized at the end of the current
+// Update cycle (or the next one if called outside of the Update tree),
+// after all objects are updated and the disposed ones are removed.
+// It's then added to the scene as usual, so its first Update
+// happens during the next frame.
+//
+// It's useful for spawning objects from another object Init
+// when they rely on the spawner being fully initialized.
+// The objects queued from the deferred Init calls
+// are initialized during the same cycle.
+func (s *Scene) AddObjectDeferred(o Object) {
+	s.checkMutation("AddObjectDeferred")
+	s.deferredObjects = append(s.deferredObjects, o)
+}
+
+func (s *Scene) initDeferredObjects() {
+	// The list can grow during the Init calls.
+	for i := 0; i < len(s.deferredObjects); i++ {
+		s.addObject(s.deferredObjects[i], nil)
+	}
+	clear(s.deferredObjects)
+	s.deferredObjects = s.deferredObjects[:0]
+}
+
+// Defer queues a function to be called at the end of the current
+// Update cycle (or the next one if called outside of the Update tree).
+//
+// The deferred functions are called after the disposed objects
+// are removed and the add-queue is flushed, in the order they were queued.
+// This gives a safe point to modify the scene structure
+// from deep inside the object updates.
+//
+// The functions deferred from another deferred function
+// are called during the same cycle.
+func (s *Scene) Defer(fn func()) {
+	s.deferredCalls = append(s.deferredCalls, fn)
+}
+
+func (s *Scene) runDeferredCalls() {
+	// The list can grow during the calls.
+	for i := 0; i < len(s.deferredCalls); i++ {
+		s.deferredCalls[i]()
+	}
+	clear(s.deferredCalls)
+	s.deferredCalls = s.deferredCalls[:0]
+}
+
+// NumObjects reports the number of objects stored in the scene,
+// including the objects that are queued for addition.
+//
+// Disposed objects are counted until the scene removes them.
+func (s *Scene) NumObjects() int {
+	n := 0
+	for _, list := range s.objectLists() {
+		n += len(list)
+	}
+	return n
+}
+
+// NumGraphics reports the number of graphics stored in the scene drawer.
+// It returns -1 if the drawer doesn't implement [GraphicsCounter].
+func (s *Scene) NumGraphics() int {
+	if c, ok := s.drawer.(GraphicsCounter); ok {
+		return c.NumGraphics()
+	}
+	return -1
+}
+
+// EachObject calls fn for every scene object that is not disposed.
+// The iteration stops as soon as fn returns false.
+//
+// The iteration order is identical to the Update order,
+// the objects that are queued for addition are visited last.
+//
+// It's safe to add or remove objects from inside fn;
+// objects added during the iteration are not visited.
+func (s *Scene) EachObject(fn func(o Object) bool) {
+	for _, list := range s.objectLists() {
+		for _, so := range list {
+			if so.o.IsDisposed() {
+				continue
+			}
+			if !fn(so.o) {
+				return
+			}
+		}
+	}
+}
+
+// objectLists returns all scene object lists in the Update order.
+//
+// While the objects list is being compacted, it contains
+// some stale elements, so only its valid parts are returned.
+func (s *Scene) objectLists() [3][]sceneObject {
+	if s.compaction.active {
+		return [3][]sceneObject{
+			s.objects[:s.compaction.numLive],
+			s.objects[s.compaction.next:],
+			s.addedObjects,
+		}
+	}
+	return [3][]sceneObject{s.objects, nil, s.addedObjects}
+}
+
+// AddObjects is like [AddObject], but for several objects at once.
+//
+// The add-queue is grown only once, then every object is added
+// and initialized in the argument order.
+// This is useful when spawning hundreds of entities on the level load.
+func (s *Scene) AddObjects(objects ...Object) {
+	s.addedObjects = slices.Grow(s.addedObjects, len(objects))
+	for _, o := range objects {
+		s.addObject(o, nil)
+	}
+}
+
+// AddObjectThrottled is like [AddObject], but the object
+// Update is called only every n-th frame.
+//
+// The delta passed to such Update is a sum of all frame deltas
+// since its previous Update call.
+// The scene spreads the throttled objects across the frames
+// to avoid the update spikes.
+// This is useful for AI and pathfinding that rarely need 60Hz updates.
+//
+// Only the Update method is throttled; FixedUpdate and PostUpdate
+// are called as usual.
+//
+// See also [ThrottledObject].
+func (s *Scene) AddObjectThrottled(o Object, n int) {
+	if n <= 0 {
+		panic("update interval should be positive")
+	}
+	s.addObjectWithInterval(o, nil, n)
+}
+
+func (s *Scene) addObject(o Object, pool objectReleaser) {
+	interval := 1
+	if t, ok := o.(ThrottledObject); ok {
+		interval = t.UpdateInterval()
+	}
+	s.addObjectWithInterval(o, pool, interval)
+}
+
+func (s *Scene) addObjectWithInterval(o Object, pool objectReleaser, interval int) {
+	s.checkMutation("AddObject")
+	s.checkObjectAdd(o)
+	so := sceneObject{o: o, pool: pool, interval: interval}
+	if interval > 1 {
+		so.counter = s.throttleSeq % interval
+		s.throttleSeq++
+	}
+	s.addedObjects = append(s.addedObjects, so)
+	if s.tracer != nil {
+		s.tracer.OnObjectAdded(s, o)
+	}
 	o.Init(s)
 }
 
+// RemoveObject detaches the object from the scene without
+// requiring it to report being disposed.
+//
+// The object is removed at the end of the current Update cycle
+// (or the next one if called outside of the Update tree).
+// Its Update methods may still be called before that.
+//
+// This is useful for the shared or reusable objects
+// whose lifetime is managed externally.
+// Removing an object that is not a part of the scene is a no-op.
+func (s *Scene) RemoveObject(o Object) {
+	s.checkMutation("RemoveObject")
+	s.objectsToRemove = append(s.objectsToRemove, o)
+}
+
+// ClearObjects disposes and removes every object of this scene.
+// The scene itself and its controller stay alive.
+//
+// Every object that has a Dispose() method gets it called right away.
+// The objects are removed from the scene at the end of the current
+// Update cycle (or the next one if called outside of the Update tree).
+//
+// Objects that are added after this call are not affected,
+// so a controller can implement a "restart level" by clearing
+// the scene and then adding the new level objects.
+func (s *Scene) ClearObjects() {
+	s.checkMutation("ClearObjects")
+	// During the compaction, some of the objects list elements
+	// are stale copies of the live ones; they should not be disposed twice.
+	for _, list := range s.objectLists() {
+		for _, so := range list {
+			disposeObject(so.o)
+		}
+	}
+	s.numAddedToClear = len(s.addedObjects)
+}
+
+// ClearGraphics disposes and removes every graphics of this scene.
+//
+// Every graphics that has a Dispose() method gets it called.
+// The scene drawer should implement the [GraphicsClearer] interface,
+// otherwise this method panics.
+func (s *Scene) ClearGraphics() {
+	s.checkMutation("ClearGraphics")
+	c, ok := s.drawer.(GraphicsClearer)
+	if !ok {
+		panic("the scene drawer doesn't implement GraphicsClearer")
+	}
+	c.ClearGraphics()
+}
+
+func disposeObject(o any) {
+	if d, ok := o.(interface{ Dispose() }); ok {
+		d.Dispose()
+	}
+}
+
+// SetFixedDelta changes the fixed-timestep delta used for
+// the [FixedUpdater] objects.
+//
+// The scene accumulates the frame deltas and runs as many
+// fixed steps as it fits into the accumulated time.
+// Therefore, FixedUpdate can be called several times per frame
+// or not called at all during some frames.
+//
+// The default fixed delta is 1.0/60.0.
+func (s *Scene) SetFixedDelta(delta float64) {
+	if delta <= 0 {
+		panic("fixed delta should be a positive value")
+	}
+	s.fixedDelta = delta
+}
+
+// FixedDelta returns the current fixed-timestep delta.
+// See [SetFixedDelta].
+func (s *Scene) FixedDelta() float64 {
+	return s.fixedDelta
+}
+
+// FixedAlpha returns the render interpolation alpha in [0, 1) range.
+//
+// It reports how far the current time is between the last executed
+// fixed step and the next one. It can be used to lerp
+// between the previous and current fixed-step simulation state
+// to avoid the visual judder when TPS is lower than FPS.
+//
+// See [InterpolatedGraphics].
+func (s *Scene) FixedAlpha() float64 {
+	return s.fixedAccum / s.fixedDelta
+}
+
+// SetUnorderedRemoval changes the way disposed objects are removed.
+//
+// By default, the objects list is compacted in-place, so the objects
+// Update order always matches the AddObject order.
+//
+// When unordered removal is enabled, a disposed object is replaced
+// by the last object in the list instead (swap-remove).
+// This makes the removal cheaper for very large object counts,
+// but the objects update order is not preserved anymore.
+func (s *Scene) SetUnorderedRemoval(enabled bool) {
+	s.unorderedRemoval = enabled
+}
+
+// SetGroupPaused pauses or resumes the specified objects update group.
+//
+// Objects from the paused groups are not updated,
+// but they're still kept inside the scene (unless disposed).
+// This affects Update, FixedUpdate and PostUpdate calls.
+// The scene controller is never paused.
+//
+// This way, pausing the game can freeze the world
+// while menus and animated UI keep updating.
+//
+// See [UpdateGroup] and [GroupedObject].
+func (s *Scene) SetGroupPaused(group UpdateGroup, paused bool) {
+	if group >= 64 {
+		panic("update group value is out of range")
+	}
+	if paused {
+		s.pausedGroups |= 1 << group
+	} else {
+		s.pausedGroups &^= 1 << group
+	}
+}
+
+// IsGroupPaused reports whether the update group is paused.
+// See [SetGroupPaused].
+func (s *Scene) IsGroupPaused(group UpdateGroup) bool {
+	return s.pausedGroups&(1<<group) != 0
+}
+
+func (s *Scene) isObjectPaused(o Object) bool {
+	mask := s.pausedGroups | s.managerPausedGroups
+	if mask == 0 {
+		return false // The most common case
+	}
+	var group UpdateGroup
+	if g, ok := o.(GroupedObject); ok {
+		group = g.UpdateGroup()
+	}
+	return mask&(1<<group) != 0
+}
+
+// isGroupStopped is like [IsGroupPaused], but it also
+// respects the manager-level pause.
+func (s *Scene) isGroupStopped(group UpdateGroup) bool {
+	return (s.pausedGroups|s.managerPausedGroups)&(1<<group) != 0
+}
+
+// SetClearColor sets the background color that fills
+// the destination right before the scene graphics are drawn.
+//
+// This way, the game doesn't need a dummy full-screen graphics
+// just to paint the background.
+// For the pushed scenes (see [Manager.PushScene]), the clear color
+// covers the scenes below, so it's usually left unset for them.
+//
+// A nil color disables the clearing, this is a default.
+func (s *Scene) SetClearColor(c color.Color) {
+	s.clearColor = c
+}
+
+// SetDebugGizmos enables or disables the debug gizmos mode.
+//
+// When this mode is enabled, every scene object that implements
+// the [DebugDrawer] interface gets its DebugDraw method called
+// after the scene graphics are drawn.
+//
+// The gizmos mode is disabled by default.
+func (s *Scene) SetDebugGizmos(enabled bool) {
+	s.debugGizmos = enabled
+}
+
+// DebugGizmosEnabled reports whether the debug gizmos mode is enabled.
+// See [SetDebugGizmos].
+func (s *Scene) DebugGizmosEnabled() bool {
+	return s.debugGizmos
+}
+
+// Reserve preallocates the scene storage for the given number
+// of objects and graphics.
+//
+// Big scenes can use it during [Controller.Init] to avoid the
+// slices re-growing when a lot of objects are added.
+// The objects hint covers both the live objects storage and
+// the queue of just added objects that are waiting for
+// the end of the frame (this is where [AddObject] calls
+// made from [Controller.Init] end up).
+// The graphics capacity hint is only used if the scene drawer
+// implements [GraphicsReserver].
+func (s *Scene) Reserve(objects, graphics int) {
+	s.objects = slices.Grow(s.objects, max(0, objects-len(s.objects)))
+	s.addedObjects = slices.Grow(s.addedObjects, max(0, objects-len(s.addedObjects)))
+	if r, ok := s.drawer.(GraphicsReserver); ok {
+		r.ReserveGraphics(graphics)
+	}
+}
+
 // AddGraphics adds the graphical object to the scene
 // at the layer specified by its index.
+//
+// Within a layer, graphics are drawn in the order they were added
+// (unless the layer defines its own ordering, like Y-sort).
+// The built-in drawers also respect the [OrderedGraphics] draw order.
+// This order is stable: disposing some graphics doesn't
+// shuffle the remaining ones. See [Drawer.AddGraphics].
 func (s *Scene) AddGraphics(g Graphics, layer int) {
+	s.checkMutation("AddGraphics")
+	s.checkGraphicsAdd(g)
+	if s.tracer != nil {
+		s.tracer.OnGraphicsAdded(s, g, layer)
+	}
 	s.drawer.AddGraphics(g, layer)
 }
 
+// AddGraphicsBatch is like [AddGraphics], but for several graphics at once.
+// All graphics are added to the same layer in the argument order.
+//
+// If the scene drawer implements [BatchGraphicsAdder], it
+// can add all graphics without the per-call overhead.
+func (s *Scene) AddGraphicsBatch(layer int, graphics ...Graphics) {
+	s.checkMutation("AddGraphicsBatch")
+	for _, g := range graphics {
+		s.checkGraphicsAdd(g)
+	}
+	if s.tracer != nil {
+		for _, g := range graphics {
+			s.tracer.OnGraphicsAdded(s, g, layer)
+		}
+	}
+	if b, ok := s.drawer.(BatchGraphicsAdder); ok {
+		b.AddGraphicsBatch(graphics, layer)
+		return
+	}
+	for _, g := range graphics {
+		s.drawer.AddGraphics(g, layer)
+	}
+}
+
+// OwnResource makes the scene responsible for the resource disposal.
+//
+// Owned resources are disposed when the scene is replaced,
+// in the reverse order of their registration.
+// It's intended for the images and shaders
+// created during the [Controller.Init] that should not outlive the scene.
+// Audio players are closed rather than disposed; use [OwnAudio] for them.
+func (s *Scene) OwnResource(r interface{ Dispose() }) {
+	s.resources = append(s.resources, r)
+}
+
+func (s *Scene) disposeResources() {
+	for i := len(s.resources) - 1; i >= 0; i-- {
+		s.resources[i].Dispose()
+	}
+	s.resources = nil
+}
+
+// Fail reports a fatal error, like a missing asset or a corrupted save.
+//
+// If called inside the Update tree, the current Update is aborted
+// right away (just like the scene change does it).
+// The error is then returned by [Manager.UpdateE], so it can be
+// propagated to the [ebiten.Game] Update error result.
+//
+// The scene state is undefined after the fatal error,
+// so it should not be updated anymore.
+// Only the first reported error is kept.
+func (s *Scene) Fail(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+	if s.insideUpdate {
+		s.insideUpdate = false
+		panic(stopUpdate)
+	}
+}
+
+// Err returns the fatal error reported via [Fail].
+func (s *Scene) Err() error {
+	return s.err
+}
+
 // dispose stops the current scene execution (even mid-update) and
 // discards the scene state.
 //
@@ -80,9 +701,39 @@ func (s *Scene) AddGraphics(g Graphics, layer int) {
 //
 // After this scene is disposed, it should not be used any further.
 func (s *Scene) dispose() {
+	s.disposed = true
+	s.releaseInput()
+	s.disposeResources()
+	if s.freeze != nil {
+		s.SetFrozen(false, nil)
+	}
+	if s.debugChecks != nil {
+		s.debugChecks.forgetScene(s)
+	}
+	for _, n := range s.nested {
+		n.disposeWithOuter()
+	}
+	s.nested = nil
+
 	s.objects = nil
 	s.addedObjects = nil
+	s.removedObjects = nil
+	s.objectsToRemove = nil
+	s.deferredObjects = nil
+	s.deferredCalls = nil
+	s.commands = nil
+	s.services = nil
+	s.budgeted = nil
+	s.schedule = nil
+	s.tickSchedule = nil
+	s.postUpdaters = nil
+	s.phaseHandlers = [numUpdatePhases][]phaseHandler{}
+	s.systems = nil
+	s.systemsOrder = nil
+	s.timers = nil
+	s.fixedUpdaters = nil
 	s.controllerObject = nil
+	s.subControllers = nil
 	s.drawer = nil
 
 	if s.insideUpdate {
@@ -91,10 +742,6 @@ func (s *Scene) dispose() {
 	}
 }
 
-func (s *Scene) update() {
-	s.updateWithDelta(1.0 / 60.0)
-}
-
 func (s *Scene) updateWithDelta(delta float64) {
 	// We have two methods: updateWithDelta and updateWithDeltaImpl.
 	// updateWithDelta is needed to create a guarding defer call
@@ -121,35 +768,372 @@ func (s *Scene) updateWithDelta(delta float64) {
 }
 
 func (s *Scene) updateWithDeltaImpl(delta float64) {
+	t := s.statsStart()
+	var budgetStart time.Time
+	if s.updateBudget > 0 {
+		budgetStart = time.Now()
+	}
+
+	s.time += delta
+	s.tick++
+
+	if len(s.timers) != 0 {
+		s.advanceTimers(delta)
+	}
+
+	// Fixed steps are executed before the regular frame update.
+	s.fixedAccum += delta
+	for s.fixedAccum >= s.fixedDelta {
+		s.fixedAccum -= s.fixedDelta
+		s.fixedUpdate(s.fixedDelta)
+	}
+	t = s.statsLap(&s.stats.FixedUpdateTime, t)
+
+	if len(s.schedule) != 0 {
+		s.runScheduledEvents()
+	}
+	if len(s.tickSchedule) != 0 {
+		s.runTickEvents()
+	}
+
+	if len(s.phaseHandlers[PhaseInput]) != 0 {
+		s.runPhase(PhaseInput, delta)
+	}
+
 	// The scene controller receives the Update call first.
-	s.controllerObject.Update(delta)
+	if len(s.subControllers) != 0 {
+		s.updateControllers(delta)
+	} else {
+		s.controllerObject.Update(delta)
+	}
+	t = s.statsLap(&s.stats.ControllerUpdateTime, t)
+
+	if s.unorderedRemoval {
+		s.updateObjectsUnordered(delta)
+	} else {
+		s.updateObjects(delta)
+	}
+	t = s.statsLap(&s.stats.ObjectsUpdateTime, t)
+
+	if len(s.systems) != 0 {
+		s.runSystems(delta)
+	}
+	if len(s.phaseHandlers[PhaseLogic]) != 0 {
+		s.runPhase(PhaseLogic, delta)
+	}
+	if len(s.phaseHandlers[PhasePhysics]) != 0 {
+		s.runPhase(PhasePhysics, delta)
+	}
+
+	// Post-update is executed after all objects are updated.
+	// The post-updaters list is filtered in the same way.
+	livePostUpdaters := s.postUpdaters[:0]
+	for _, o := range s.postUpdaters {
+		if o.IsDisposed() {
+			continue
+		}
+		if !s.isObjectPaused(o) {
+			o.PostUpdate(delta)
+		}
+		livePostUpdaters = append(livePostUpdaters, o)
+	}
+	s.postUpdaters = livePostUpdaters
+	t = s.statsLap(&s.stats.PostUpdateTime, t)
+
+	if len(s.commands) != 0 {
+		s.drainCommands()
+	}
+
+	if len(s.budgeted) != 0 {
+		s.stepBudgetedObjects(budgetStart)
+	}
+
+	if len(s.tasks) != 0 {
+		s.stepTasks()
+	}
+
+	// Drawer's update is called the last.
+	s.drawer.Update(delta)
+	s.statsLap(&s.stats.DrawerUpdateTime, t)
+
+	// The fixed steps are not executed every frame,
+	// so the list is filtered separately.
+	liveFixedUpdaters := s.fixedUpdaters[:0]
+	for _, o := range s.fixedUpdaters {
+		if o.IsDisposed() {
+			continue
+		}
+		liveFixedUpdaters = append(liveFixedUpdaters, o)
+	}
+	s.fixedUpdaters = liveFixedUpdaters
+
+	if len(s.phaseHandlers[PhaseCleanup]) != 0 {
+		s.runPhase(PhaseCleanup, delta)
+	}
+
+	if s.numAddedToClear >= 0 {
+		s.applyClearObjects()
+	}
+	if len(s.objectsToRemove) != 0 {
+		s.applyRemoveObject()
+	}
+	s.flushRemovedObjects()
+	if len(s.deferredObjects) != 0 {
+		s.initDeferredObjects()
+	}
+	s.flushAddedObjects()
 
+	if len(s.deferredCalls) != 0 {
+		s.runDeferredCalls()
+	}
+
+	if s.updateBudget > 0 {
+		s.checkUpdateBudget(budgetStart)
+	}
+}
+
+func (s *Scene) updateObjects(delta float64) {
 	// Call every active object's Update, filter
 	// the objects list in-place while at it.
 	liveObjects := s.objects[:0]
-	for _, o := range s.objects {
+	s.compaction.active = true
+	for i, so := range s.objects {
+		o := so.o
 		if o.IsDisposed() {
+			s.removedObjects = append(s.removedObjects, so)
 			continue
 		}
-		o.Update(delta)
-		liveObjects = append(liveObjects, o)
+		// Track the compaction state, so the objects
+		// can be iterated from inside of the Update.
+		s.compaction.numLive = len(liveObjects)
+		s.compaction.next = i
+		s.updateObject(&so, delta)
+		liveObjects = append(liveObjects, so)
 	}
+	s.compaction.active = false
 	s.objects = liveObjects
+}
 
-	// Drawer's update is called the last.
-	s.drawer.Update(delta)
+func (s *Scene) updateObject(so *sceneObject, delta float64) {
+	if s.isObjectPaused(so.o) {
+		return
+	}
+	if so.interval > 1 {
+		so.accum += delta
+		so.counter++
+		if so.counter < so.interval {
+			return
+		}
+		delta = so.accum
+		so.counter = 0
+		so.accum = 0
+	}
+	if s.profileObjectTypes {
+		s.profiledObjectUpdate(so.o, delta)
+		return
+	}
+	s.callObjectUpdate(so.o, delta)
+}
+
+func (s *Scene) callObjectUpdate(o Object, delta float64) {
+	if s.panicHandler != nil {
+		s.safeObjectUpdate(o, delta)
+		return
+	}
+	o.Update(delta)
+}
+
+func (s *Scene) profiledObjectUpdate(o Object, delta float64) {
+	start := time.Now()
+	s.callObjectUpdate(o, delta)
+	if s.stats.ObjectTypeTimes == nil {
+		s.stats.ObjectTypeTimes = make(map[reflect.Type]time.Duration)
+	}
+	s.stats.ObjectTypeTimes[reflect.TypeOf(o)] += time.Since(start)
+}
+
+func (s *Scene) updateObjectsUnordered(delta float64) {
+	// Disposed objects are replaced by the last list element.
+	// The swapped-in element is checked during the same iteration.
+	i := 0
+	for i < len(s.objects) {
+		so := s.objects[i]
+		o := so.o
+		if o.IsDisposed() {
+			s.removedObjects = append(s.removedObjects, so)
+			last := len(s.objects) - 1
+			s.objects[i] = s.objects[last]
+			s.objects[last] = sceneObject{}
+			s.objects = s.objects[:last]
+			continue
+		}
+		s.updateObject(&s.objects[i], delta)
+		i++
+	}
+}
+
+func (s *Scene) fixedUpdate(delta float64) {
+	if c, ok := s.controllerImpl().(FixedUpdater); ok {
+		c.FixedUpdate(delta)
+	}
+
+	for _, o := range s.fixedUpdaters {
+		if o.IsDisposed() || s.isObjectPaused(o) {
+			continue
+		}
+		o.FixedUpdate(delta)
+	}
+}
+
+func (s *Scene) applyClearObjects() {
+	// All live objects are removed, so the derived lists are cleared too.
+	// Only the add-queue objects that were there during
+	// the ClearObjects call are removed.
+	s.removedObjects = append(s.removedObjects, s.objects...)
+	s.removedObjects = append(s.removedObjects, s.addedObjects[:s.numAddedToClear]...)
+	clear(s.objects)
+	s.objects = s.objects[:0]
+	clear(s.postUpdaters)
+	s.postUpdaters = s.postUpdaters[:0]
+	clear(s.fixedUpdaters)
+	s.fixedUpdaters = s.fixedUpdaters[:0]
+	clear(s.budgeted)
+	s.budgeted = s.budgeted[:0]
+	s.budgetCursor = 0
+	s.addedObjects = slices.Delete(s.addedObjects, 0, s.numAddedToClear)
+	s.numAddedToClear = -1
+}
+
+func (s *Scene) applyRemoveObject() {
+	for _, o := range s.objectsToRemove {
+		if i := s.indexOfObject(s.objects, o); i != -1 {
+			s.removedObjects = append(s.removedObjects, s.objects[i])
+			s.objects = slices.Delete(s.objects, i, i+1)
+		} else if i := s.indexOfObject(s.addedObjects, o); i != -1 {
+			s.removedObjects = append(s.removedObjects, s.addedObjects[i])
+			s.addedObjects = slices.Delete(s.addedObjects, i, i+1)
+		}
+		if pu, ok := o.(postUpdaterObject); ok {
+			if i := slices.Index(s.postUpdaters, pu); i != -1 {
+				s.postUpdaters = slices.Delete(s.postUpdaters, i, i+1)
+			}
+		}
+		if fu, ok := o.(fixedUpdaterObject); ok {
+			if i := slices.Index(s.fixedUpdaters, fu); i != -1 {
+				s.fixedUpdaters = slices.Delete(s.fixedUpdaters, i, i+1)
+			}
+		}
+		if bu, ok := o.(budgetedObject); ok {
+			s.removeBudgeted(bu)
+		}
+	}
+	clear(s.objectsToRemove)
+	s.objectsToRemove = s.objectsToRemove[:0]
+}
+
+func (s *Scene) indexOfObject(list []sceneObject, o Object) int {
+	for i, so := range list {
+		if so.o == o {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Scene) flushRemovedObjects() {
+	if s.debugChecks != nil {
+		s.debugChecks.forgetRemoved(s.removedObjects)
+	}
+
+	s.stats.ObjectsRemoved += len(s.removedObjects)
+
+	// This is executed after all object lists are filtered,
+	// so it's safe to re-use the removed objects from now on.
+	for _, so := range s.removedObjects {
+		if s.tracer != nil {
+			s.tracer.OnObjectRemoved(s, so.o)
+		}
+		if ro, ok := so.o.(RemovalObserver); ok {
+			ro.OnRemovedFromScene(s)
+		}
+		if so.pool != nil {
+			so.pool.release(so.o)
+		}
+	}
+	clear(s.removedObjects)
+	s.removedObjects = s.removedObjects[:0]
+}
+
+func (s *Scene) flushAddedObjects() {
+	s.stats.ObjectsAdded += len(s.addedObjects)
 
 	// Even if some of the added objects are already disposed,
 	// they can be added here and removed during the next Update.
 	s.objects = append(s.objects, s.addedObjects...)
+	for _, so := range s.addedObjects {
+		o := so.o
+		if pu, ok := o.(postUpdaterObject); ok {
+			s.postUpdaters = append(s.postUpdaters, pu)
+		}
+		if fu, ok := o.(fixedUpdaterObject); ok {
+			s.fixedUpdaters = append(s.fixedUpdaters, fu)
+		}
+		if bu, ok := o.(budgetedObject); ok {
+			s.budgeted = append(s.budgeted, budgetedEntry{o: bu, lastTime: s.time})
+		}
+	}
+	clear(s.addedObjects)
 	s.addedObjects = s.addedObjects[:0]
 }
 
 func (s *Scene) draw(dst *ebiten.Image) {
+	s.insideDraw = true
+
+	t := s.statsStart()
+
+	if s.freeze != nil {
+		s.drawFrozen(dst)
+	} else {
+		s.drawGraphics(dst)
+	}
+
+	if s.debugGizmos {
+		s.drawGizmos(dst)
+		s.drawWatches(dst)
+	}
+
+	s.statsLap(&s.stats.DrawTime, t)
+
+	s.insideDraw = false
+}
+
+func (s *Scene) drawGraphics(dst *ebiten.Image) {
+	if a, ok := s.drawer.(InterpolationAlphaSetter); ok {
+		a.SetInterpolationAlpha(s.FixedAlpha())
+	}
+	if s.clearColor != nil {
+		dst.Fill(s.clearColor)
+	}
 	s.drawer.Draw(dst)
 }
 
+func (s *Scene) drawGizmos(dst *ebiten.Image) {
+	for _, so := range s.objects {
+		o := so.o
+		if o.IsDisposed() {
+			continue
+		}
+		if d, ok := o.(DebugDrawer); ok {
+			d.DebugDraw(dst)
+		}
+	}
+}
+
 func (s *Scene) setDrawer(d Drawer) {
+	if s.headless {
+		return
+	}
+
 	// A simple sanity check.
 	if d, ok := d.(*simpleDrawer); ok {
 		if len(d.graphics) > 0 {
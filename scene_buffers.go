@@ -0,0 +1,45 @@
+package gscene
+
+// sceneBuffers holds the storage slices of a disposed scene,
+// so they can be re-used by the next scene.
+//
+// This way, rapid scene switching (menus, level restarts)
+// doesn't churn the GC with new slice allocations.
+type sceneBuffers struct {
+	objects      []sceneObject
+	addedObjects []sceneObject
+	graphics     []Graphics
+}
+
+// takeSceneBuffers extracts the storage slices from the scene
+// that is about to be disposed.
+//
+// All slice elements are cleared, so the recycled buffers
+// don't keep the old scene objects alive.
+func takeSceneBuffers(s *Scene) *sceneBuffers {
+	b := &sceneBuffers{
+		objects:      clearSlice(s.objects),
+		addedObjects: clearSlice(s.addedObjects),
+	}
+	if d, ok := s.drawer.(*simpleDrawer); ok {
+		b.graphics = clearSlice(d.graphics)
+	}
+	return b
+}
+
+func (b *sceneBuffers) apply(s *Scene) {
+	if cap(b.objects) > cap(s.objects) {
+		s.objects = b.objects
+	}
+	if cap(b.addedObjects) > cap(s.addedObjects) {
+		s.addedObjects = b.addedObjects
+	}
+	if d, ok := s.drawer.(*simpleDrawer); ok && b.graphics != nil {
+		d.graphics = b.graphics
+	}
+}
+
+func clearSlice[T any](list []T) []T {
+	clear(list[:cap(list)])
+	return list[:0]
+}
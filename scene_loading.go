@@ -0,0 +1,68 @@
+package gscene
+
+// SceneLoader performs the scene loading work in small steps.
+// See [Manager.ChangeSceneLoading].
+type SceneLoader interface {
+	// LoadStep executes the next portion of the loading work.
+	//
+	// It should return the loading progress in [0, 1] range
+	// and whether the loading is complete.
+	// Every step should be short enough to fit into a single frame.
+	LoadStep() (progress float64, done bool)
+}
+
+type sceneLoading struct {
+	target   Controller
+	loader   SceneLoader
+	progress float64
+}
+
+// ChangeSceneLoading installs the loading scene and starts
+// loading the target scene in the background.
+//
+// The loading scene (driven by the loading controller) works like any
+// other scene: it has its own full Update and Draw cycle,
+// so the spinners, tips rotation, and progress bar objects keep animating.
+//
+// The loader steps are executed by the [Manager.UpdateWithDelta] method
+// after the loading scene Update tree is finished, one step per frame.
+// This guarantees that the loading scene is updated and drawn
+// every frame even if the loading itself takes many frames.
+//
+// When the loader reports completion, the target scene is installed
+// as if [Manager.ChangeScene] was called with the target controller.
+// Use [Manager.LoadingProgress] to display the loading progress.
+//
+// Calling [Manager.ChangeScene] while the loading is in progress cancels it.
+func (m *Manager) ChangeSceneLoading(loading, target Controller, loader SceneLoader) {
+	m.pendingLoad = &sceneLoading{
+		target: target,
+		loader: loader,
+	}
+	m.changeScene(loading, SceneOptions{})
+}
+
+// IsLoading reports whether there is a scene loading in progress.
+// See [ChangeSceneLoading].
+func (m *Manager) IsLoading() bool {
+	return m.pendingLoad != nil
+}
+
+// LoadingProgress returns the last reported scene loading progress.
+// It returns 0 if there is no scene loading in progress.
+func (m *Manager) LoadingProgress() float64 {
+	if m.pendingLoad == nil {
+		return 0
+	}
+	return m.pendingLoad.progress
+}
+
+func (m *Manager) stepSceneLoading() {
+	load := m.pendingLoad
+	progress, done := load.loader.LoadStep()
+	load.progress = progress
+	if done {
+		m.pendingLoad = nil
+		m.changeScene(load.target, SceneOptions{})
+	}
+}
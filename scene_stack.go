@@ -0,0 +1,112 @@
+package gscene
+
+// PushScene puts a new scene on top of the current one.
+//
+// Unlike [ChangeScene], the current scene is not disposed:
+// it keeps being updated and drawn below the new scene.
+// Use [PopScene] to dispose the new scene and return to the previous one.
+//
+// If modal is true, the scenes below the new one are input-blocked
+// (see [Scene.IsInputBlocked]). The controllers of such scenes
+// should not handle the user input while the modal scene is active.
+// This is useful for the confirmation dialogs and pause menus.
+//
+// [ChangeScene] disposes all stacked scenes.
+func (m *Manager) PushScene(c Controller, modal bool) {
+	if m.insideDraw {
+		m.deferSceneChange(func() { m.PushScene(c, modal) })
+		return
+	}
+	prevScene := m.currentScene
+	m.sceneStack = append(m.sceneStack, prevScene)
+	// Only the current scene collects the stats.
+	prevScene.statsEnabled = false
+
+	var opts SceneOptions
+	if m.replay != nil {
+		opts = m.replaySceneOptions(opts, false)
+	}
+	s := m.newScene(c, opts)
+	s.modal = modal
+	m.startScene(s, prevScene)
+}
+
+// PopScene disposes the current scene and makes
+// the scene below it current again.
+// It panics if there is no scene below.
+//
+// Just like [ChangeScene], it should be treated as a control transfer
+// call if it's called from the current scene Update tree.
+func (m *Manager) PopScene() {
+	if m.insideDraw {
+		m.deferSceneChange(m.PopScene)
+		return
+	}
+	n := len(m.sceneStack)
+	if n == 0 {
+		panic("pop from an empty scene stack")
+	}
+	top := m.currentScene
+	m.currentScene = m.sceneStack[n-1]
+	m.sceneStack[n-1] = nil
+	m.sceneStack = m.sceneStack[:n-1]
+
+	m.syncStatsEnabled()
+	m.syncSceneStack()
+	if m.tracer != nil {
+		m.tracer.OnSceneChanged(top, m.currentScene)
+	}
+	m.syncSceneFocus(m.currentScene)
+	m.disposeScenes([]*Scene{top}, nil)
+}
+
+// NumStackedScenes reports the number of scenes below the current one.
+func (m *Manager) NumStackedScenes() int {
+	return len(m.sceneStack)
+}
+
+// IsTopmost reports whether this scene is the current manager scene.
+// The scenes below the pushed ones are not topmost.
+// See [Manager.PushScene].
+func (s *Scene) IsTopmost() bool {
+	return s.topmost
+}
+
+// IsInputBlocked reports whether there is a modal scene above this one.
+// See [Manager.PushScene].
+func (s *Scene) IsInputBlocked() bool {
+	return s.inputBlocked
+}
+
+func (m *Manager) syncSceneStack() {
+	blocked := false
+	m.currentScene.topmost = true
+	m.currentScene.inputBlocked = false
+	if m.currentScene.modal {
+		blocked = true
+	}
+	for i := len(m.sceneStack) - 1; i >= 0; i-- {
+		s := m.sceneStack[i]
+		s.topmost = false
+		s.inputBlocked = blocked
+		if s.modal {
+			blocked = true
+		}
+	}
+}
+
+// updateStackedScenes updates the scenes below the current one.
+// It reports whether the current scene should be updated as well.
+func (m *Manager) updateStackedScenes(delta float64) bool {
+	current := m.currentScene
+	for i := 0; i < len(m.sceneStack); i++ {
+		m.sceneStack[i].updateWithDelta(delta)
+		if m.currentScene != current {
+			// The scene was changed, pushed, or popped
+			// during the Update, the new scene will be
+			// updated during the next frame.
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// disposeCounter is an object that counts its Dispose calls.
+type disposeCounter struct {
+	gscenetest.Object
+	numDisposed int
+}
+
+func (o *disposeCounter) Dispose() {
+	o.numDisposed++
+	o.Object.Dispose()
+}
+
+func TestClearObjectsFromObjectUpdate(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	scene := m.CurrentScene()
+
+	// The objects list is compacted while the objects are updated.
+	// The disposed object in front of the clearing object makes
+	// the list contain the stale copies during the ClearObjects call.
+	objects := make([]*disposeCounter, 5)
+	for i := range objects {
+		objects[i] = &disposeCounter{Object: gscenetest.Object{Recorder: r}}
+		scene.AddObject(objects[i])
+	}
+	if err := gscenetest.RunFrames(m, 1, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+
+	objects[0].Dispose()
+	objects[3].OnUpdate = func(delta float64) {
+		scene.ClearObjects()
+	}
+	if err := gscenetest.RunFrames(m, 1, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	for i, o := range objects[1:] {
+		if o.numDisposed != 1 {
+			t.Fatalf("object %d: disposed %d times", i+1, o.numDisposed)
+		}
+	}
+	if n := scene.NumObjects(); n != 0 {
+		t.Fatalf("have %d objects after the clear", n)
+	}
+}
@@ -0,0 +1,158 @@
+package gscene
+
+import (
+	"container/heap"
+)
+
+// ScheduledEvent is a handle of the event created by [Scene.Schedule].
+type ScheduledEvent struct {
+	at       float64
+	seq      uint64
+	fn       func()
+	canceled bool
+
+	// interval is a tick period of the recurring events.
+	interval uint64
+
+	// queue and index locate the pending event,
+	// so it can be removed from the queue right away.
+	// The index is -1 for the events that are not queued.
+	queue *eventQueue
+	index int
+}
+
+// Cancel prevents the event from being executed.
+//
+// The canceled event is removed from the scene queue right away,
+// so its function is released without waiting for the event time.
+func (e *ScheduledEvent) Cancel() {
+	e.canceled = true
+	e.fn = nil
+	if e.queue == nil || e.index < 0 {
+		return
+	}
+	q := *e.queue
+	if e.index < len(q) && q[e.index] == e {
+		heap.Remove(e.queue, e.index)
+	}
+}
+
+// IsCanceled reports whether the event was canceled.
+func (e *ScheduledEvent) IsCanceled() bool { return e.canceled }
+
+// Schedule queues a function to be called once the scene time
+// reaches the specified moment (see [Scene.Time]).
+//
+// The events are kept in a priority queue, so hundreds of
+// future events (buff expirations, wave spawns) don't need
+// their own countdowns inside the objects Update.
+// Since the scene time is scaled, the events respect the time scale.
+//
+// The due events are executed during the Update cycle,
+// right before the controller Update, ordered by their time.
+// The events with the same time are executed in the order they were scheduled.
+// An event scheduled for the past is executed during the next Update.
+//
+// The pending events are discarded together with the scene.
+func (s *Scene) Schedule(at float64, fn func()) *ScheduledEvent {
+	e := &ScheduledEvent{at: at, seq: s.scheduleSeq, fn: fn, queue: &s.schedule}
+	s.scheduleSeq++
+	heap.Push(&s.schedule, e)
+	return e
+}
+
+// ScheduleAfter is like [Schedule], but the time
+// is relative to the current scene time.
+func (s *Scene) ScheduleAfter(delay float64, fn func()) *ScheduledEvent {
+	return s.Schedule(s.time+delay, fn)
+}
+
+// AtTick is like [Schedule], but the moment is specified
+// using the scene frame counter (see [Scene.Tick]).
+//
+// The tick-based events are useful for the systems that
+// need the frame-count semantics (netcode, deterministic simulations)
+// rather than the float seconds.
+// The event is executed during the Update with the specified tick.
+// An event scheduled for the past tick is executed during the next Update.
+func (s *Scene) AtTick(tick uint64, fn func()) *ScheduledEvent {
+	e := &ScheduledEvent{at: float64(tick), seq: s.scheduleSeq, fn: fn, queue: &s.tickSchedule}
+	s.scheduleSeq++
+	heap.Push(&s.tickSchedule, e)
+	return e
+}
+
+// EveryNTicks makes the function called every n-th Update,
+// starting n ticks from now.
+// The returned event can be used to stop the calls, see [ScheduledEvent.Cancel].
+func (s *Scene) EveryNTicks(n uint64, fn func()) *ScheduledEvent {
+	if n == 0 {
+		panic("tick interval should be positive")
+	}
+	e := s.AtTick(s.tick+n, fn)
+	e.interval = n
+	return e
+}
+
+func (s *Scene) runTickEvents() {
+	tick := float64(s.tick)
+	for len(s.tickSchedule) != 0 && s.tickSchedule[0].at <= tick {
+		e := heap.Pop(&s.tickSchedule).(*ScheduledEvent)
+		if e.canceled {
+			continue
+		}
+		if e.interval != 0 {
+			// The recurring event is re-queued before the call,
+			// so it can be canceled from inside of it.
+			e.at = tick + float64(e.interval)
+			e.seq = s.scheduleSeq
+			s.scheduleSeq++
+			heap.Push(&s.tickSchedule, e)
+		}
+		e.fn()
+	}
+}
+
+func (s *Scene) runScheduledEvents() {
+	for len(s.schedule) != 0 && s.schedule[0].at <= s.time {
+		e := heap.Pop(&s.schedule).(*ScheduledEvent)
+		if e.canceled {
+			continue
+		}
+		e.fn()
+	}
+}
+
+// eventQueue implements the [heap.Interface].
+type eventQueue []*ScheduledEvent
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *eventQueue) Push(x any) {
+	e := x.(*ScheduledEvent)
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *eventQueue) Pop() any {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	e.index = -1
+	*q = old[:n-1]
+	return e
+}
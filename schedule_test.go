@@ -0,0 +1,58 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestScheduleCancel(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	scene := m.CurrentScene()
+
+	var fired []int
+	events := make([]*gscene.ScheduledEvent, 6)
+	for i := range events {
+		i := i
+		events[i] = scene.ScheduleAfter(float64(i)*0.25, func() {
+			fired = append(fired, i)
+		})
+	}
+	events[0].Cancel()
+	events[3].Cancel()
+	events[3].Cancel()
+	events[5].Cancel()
+	if !events[3].IsCanceled() || events[1].IsCanceled() {
+		t.Fatal("unexpected IsCanceled result")
+	}
+
+	if err := gscenetest.RunFrames(m, 8, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	if len(fired) != 3 || fired[0] != 1 || fired[1] != 2 || fired[2] != 4 {
+		t.Fatalf("have %v events fired, want [1 2 4]", fired)
+	}
+}
+
+func TestEveryNTicksCancel(t *testing.T) {
+	r := &gscenetest.Recorder{}
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: r})
+	scene := m.CurrentScene()
+
+	calls := 0
+	var e *gscene.ScheduledEvent
+	e = scene.EveryNTicks(2, func() {
+		calls++
+		if calls == 3 {
+			e.Cancel()
+		}
+	})
+	if err := gscenetest.RunFrames(m, 20, 1.0/60.0); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Fatalf("have %d calls, want 3", calls)
+	}
+}
@@ -0,0 +1,45 @@
+package gscene
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SetService registers a scene-scoped service under the given key.
+//
+// The services like the physics world, audio mixer, or pathfinding grid
+// are usually registered in [Controller.Init] and then
+// discovered by the objects in their Init methods.
+// This way, the scene systems don't need to be global.
+//
+// Registering a service with an existing key replaces the old one.
+// The key should be comparable, just like a map key.
+func (s *Scene) SetService(key, value any) {
+	if s.services == nil {
+		s.services = make(map[any]any)
+	}
+	s.services[key] = value
+}
+
+// GetService returns the service registered under the given key.
+// It reports false if there is no such service.
+func (s *Scene) GetService(key any) (any, bool) {
+	v, ok := s.services[key]
+	return v, ok
+}
+
+// SetServiceOf is like [Scene.SetService], but the service
+// is registered under its type T.
+func SetServiceOf[T any](s *Scene, value T) {
+	s.SetService(reflect.TypeFor[T](), value)
+}
+
+// ServiceOf returns the service registered with [SetServiceOf].
+// It panics if there is no such service.
+func ServiceOf[T any](s *Scene) T {
+	v, ok := s.GetService(reflect.TypeFor[T]())
+	if !ok {
+		panic(fmt.Sprintf("the scene has no %s service", reflect.TypeFor[T]()))
+	}
+	return v.(T)
+}
@@ -1,12 +1,29 @@
 package gscene
 
 import (
+	"cmp"
+	"slices"
+
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 type simpleDrawer struct {
 	graphics   []Graphics
 	needFilter bool
+	alpha      float64
+
+	// hasOrdered is set when some graphics implements OrderedGraphics.
+	hasOrdered bool
+
+	// compare is a custom sorting function.
+	// See [LayeredDrawer.SetLayerSort].
+	compare func(a, b Graphics) int
+
+	// sorted is the graphics list in the drawing order.
+	// The graphics list itself is always kept in the order
+	// the graphics were added, so the sorting result
+	// doesn't depend on the previous frames.
+	sorted []Graphics
 }
 
 func newSimpleDrawer() *simpleDrawer {
@@ -18,6 +35,9 @@ func (d *simpleDrawer) Update(delta float64) {
 }
 
 func (d *simpleDrawer) filter() {
+	// The in-place compaction is stable: the remaining
+	// graphics keep their relative order.
+	// This is a part of the Drawer ordering contract.
 	liveGraphics := d.graphics[:0]
 	for _, g := range d.graphics {
 		if g.IsDisposed() {
@@ -25,18 +45,55 @@ func (d *simpleDrawer) filter() {
 		}
 		liveGraphics = append(liveGraphics, g)
 	}
+	clear(d.graphics[len(liveGraphics):])
 	d.graphics = liveGraphics
 }
 
 func (d *simpleDrawer) Draw(dst *ebiten.Image) {
+	d.draw(dst, nil)
+}
+
+func (d *simpleDrawer) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	d.draw(dst, &geom)
+}
+
+// DrawLayers only knows about the layer 0 as all graphics belong to it.
+func (d *simpleDrawer) DrawLayers(dst *ebiten.Image, geom ebiten.GeoM, layers []int) {
+	if slices.Contains(layers, 0) {
+		d.draw(dst, optionalGeoM(geom))
+	}
+}
+
+func (d *simpleDrawer) draw(dst *ebiten.Image, geom *ebiten.GeoM) {
+	for _, g := range d.prepare() {
+		drawGraphics(dst, g, geom, d.alpha)
+	}
+}
+
+// prepare removes the disposed graphics and
+// returns the graphics list in the drawing order.
+func (d *simpleDrawer) prepare() []Graphics {
 	if d.needFilter {
 		d.filter()
 	}
 	d.needFilter = false
+	return d.ordered()
+}
 
-	for _, g := range d.graphics {
-		g.Draw(dst)
+// drawGraphics draws g using the most specific method it implements.
+// A nil geom means that there is no extra transform to apply.
+func drawGraphics(dst *ebiten.Image, g Graphics, geom *ebiten.GeoM, alpha float64) {
+	if geom != nil {
+		if tg, ok := g.(TransformableGraphics); ok {
+			tg.DrawTransformed(dst, *geom)
+			return
+		}
+	}
+	if ig, ok := g.(InterpolatedGraphics); ok {
+		ig.DrawInterpolated(dst, alpha)
+		return
 	}
+	g.Draw(dst)
 }
 
 func (d *simpleDrawer) AddGraphics(g Graphics, layer int) {
@@ -46,4 +103,92 @@ func (d *simpleDrawer) AddGraphics(g Graphics, layer int) {
 
 	d.graphics = append(d.graphics, g)
 	d.needFilter = true
+	if _, ok := g.(OrderedGraphics); ok {
+		d.hasOrdered = true
+	}
+}
+
+func (d *simpleDrawer) AddGraphicsBatch(graphics []Graphics, layer int) {
+	d.graphics = slices.Grow(d.graphics, len(graphics))
+	d.graphics = append(d.graphics, graphics...)
+	d.needFilter = true
+	if !d.hasOrdered {
+		for _, g := range graphics {
+			if _, ok := g.(OrderedGraphics); ok {
+				d.hasOrdered = true
+				break
+			}
+		}
+	}
+}
+
+// ordered returns the graphics list in the drawing order.
+func (d *simpleDrawer) ordered() []Graphics {
+	compare := d.compare
+	if compare == nil {
+		if !d.hasOrdered {
+			return d.graphics
+		}
+		compare = compareDrawOrder
+	}
+	// The draw order can change at any time, so the list
+	// is re-checked every frame. The sorting is stable and
+	// it always starts from the addition order, so the graphics
+	// with equal order are drawn in the order they were added.
+	if slices.IsSortedFunc(d.graphics, compare) {
+		return d.graphics
+	}
+	clear(d.sorted)
+	d.sorted = append(d.sorted[:0], d.graphics...)
+	slices.SortStableFunc(d.sorted, compare)
+	return d.sorted
+}
+
+func compareDrawOrder(a, b Graphics) int {
+	return cmp.Compare(graphicsDrawOrder(a), graphicsDrawOrder(b))
+}
+
+func graphicsDrawOrder(g Graphics) int {
+	if o, ok := g.(OrderedGraphics); ok {
+		return o.DrawOrder()
+	}
+	return 0
+}
+
+func (d *simpleDrawer) ReserveGraphics(n int) {
+	d.graphics = slices.Grow(d.graphics, max(0, n-len(d.graphics)))
+}
+
+func (d *simpleDrawer) SetInterpolationAlpha(alpha float64) {
+	d.alpha = alpha
+}
+
+func (d *simpleDrawer) ClearGraphics() {
+	for _, g := range d.graphics {
+		disposeObject(g)
+	}
+	clear(d.graphics)
+	d.graphics = d.graphics[:0]
+	clear(d.sorted)
+	d.sorted = d.sorted[:0]
+}
+
+func (d *simpleDrawer) EachGraphics(fn func(g Graphics, layer int) bool) {
+	d.eachGraphics(0, fn)
+}
+
+func (d *simpleDrawer) eachGraphics(layer int, fn func(g Graphics, layer int) bool) bool {
+	for _, g := range d.ordered() {
+		if g.IsDisposed() {
+			continue
+		}
+		if !fn(g, layer) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *simpleDrawer) NumGraphics() int {
+	return len(d.graphics)
 }
@@ -0,0 +1,125 @@
+package gscene_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// testGraphics records its Draw calls into the shared log.
+type testGraphics struct {
+	name     string
+	log      *[]string
+	order    int
+	disposed bool
+}
+
+func (g *testGraphics) Draw(dst *ebiten.Image) { *g.log = append(*g.log, g.name) }
+func (g *testGraphics) IsDisposed() bool       { return g.disposed }
+func (g *testGraphics) Dispose()               { g.disposed = true }
+
+// orderedTestGraphics implements the OrderedGraphics interface.
+type orderedTestGraphics struct {
+	testGraphics
+}
+
+func (g *orderedTestGraphics) DrawOrder() int { return g.order }
+
+func checkDrawLog(t *testing.T, log []string, want ...string) {
+	t.Helper()
+	have := strings.Join(log, " ")
+	if have != strings.Join(want, " ") {
+		t.Fatalf("draw order mismatch:\nhave: %s\nwant: %s", have, strings.Join(want, " "))
+	}
+}
+
+func TestSimpleDrawerOrderAfterDispose(t *testing.T) {
+	// The default drawer is only used by the non-headless managers.
+	m := gscene.NewManager()
+	m.ChangeScene(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	scene := m.CurrentScene()
+	dst := ebiten.NewImage(8, 8)
+
+	var log []string
+	graphics := map[string]*testGraphics{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		g := &testGraphics{name: name, log: &log}
+		graphics[name] = g
+		scene.AddGraphics(g, 0)
+	}
+	draw := func() []string {
+		log = log[:0]
+		m.UpdateWithDelta(1.0 / 60.0)
+		m.Draw(dst)
+		return log
+	}
+
+	checkDrawLog(t, draw(), "a", "b", "c", "d", "e", "f")
+
+	graphics["c"].Dispose()
+	graphics["d"].Dispose()
+	checkDrawLog(t, draw(), "a", "b", "e", "f")
+	checkDrawLog(t, draw(), "a", "b", "e", "f")
+
+	// A disposed graphics can be added again;
+	// it goes after the graphics that were added before.
+	graphics["c"].disposed = false
+	scene.AddGraphics(graphics["c"], 0)
+	scene.AddGraphics(&testGraphics{name: "g", log: &log}, 0)
+	checkDrawLog(t, draw(), "a", "b", "e", "f", "c", "g")
+
+	graphics["a"].Dispose()
+	graphics["f"].Dispose()
+	checkDrawLog(t, draw(), "b", "e", "c", "g")
+}
+
+func TestSimpleDrawerOrderedGraphics(t *testing.T) {
+	// The default drawer is only used by the non-headless managers.
+	m := gscene.NewManager()
+	m.ChangeScene(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	scene := m.CurrentScene()
+	dst := ebiten.NewImage(8, 8)
+
+	var log []string
+	newOrdered := func(name string, order int) *orderedTestGraphics {
+		g := &orderedTestGraphics{testGraphics{name: name, log: &log, order: order}}
+		scene.AddGraphics(g, 0)
+		return g
+	}
+	newPlain := func(name string) *testGraphics {
+		g := &testGraphics{name: name, log: &log}
+		scene.AddGraphics(g, 0)
+		return g
+	}
+	draw := func() []string {
+		log = log[:0]
+		m.UpdateWithDelta(1.0 / 60.0)
+		m.Draw(dst)
+		return log
+	}
+
+	a := newOrdered("a", 1)
+	b := newPlain("b")
+	c := newOrdered("c", -1)
+	newOrdered("d", 1)
+	newPlain("e")
+
+	// The plain graphics have the order of 0.
+	checkDrawLog(t, draw(), "c", "b", "e", "a", "d")
+
+	// The graphics with equal order are drawn in the order
+	// they were added, not in the order of the previous frame.
+	b.Dispose()
+	a.order = -1
+	checkDrawLog(t, draw(), "a", "c", "e", "d")
+
+	c.Dispose()
+	a.order = 1
+	checkDrawLog(t, draw(), "e", "a", "d")
+
+	newOrdered("f", 0)
+	checkDrawLog(t, draw(), "e", "f", "a", "d")
+}
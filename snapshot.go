@@ -0,0 +1,127 @@
+package gscene
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+// Snapshotter is an optional interface for the scene [Object] and [Controller].
+//
+// The participating objects are serialized by [Scene.SaveState]
+// and restored by [Scene.LoadState].
+// The scene doesn't create or remove any objects during the state loading,
+// it only passes the saved data to the objects with the matching keys.
+type Snapshotter interface {
+	// SnapshotKey returns a scene-unique identifier of this object.
+	// The key should be stable, so the state saved by one object
+	// can be loaded by its counterpart later.
+	SnapshotKey() string
+
+	// SaveState serializes the object state.
+	SaveState() ([]byte, error)
+
+	// LoadState restores the object state using the data
+	// returned by SaveState earlier.
+	LoadState(data []byte) error
+}
+
+type snapshotEntry struct {
+	Key  string
+	Data []byte
+}
+
+// SaveState serializes the state of all participating objects.
+// The controller and the objects participate by implementing [Snapshotter].
+//
+// Disposed objects are not included in the snapshot.
+// It's an error to have several participants with the same key.
+//
+// It's intended for the quick-save and the rollback features.
+// The returned data can be passed to [LoadState] later.
+func (s *Scene) SaveState() ([]byte, error) {
+	var entries []snapshotEntry
+	keys := make(map[string]struct{})
+	var err error
+	s.eachSnapshotter(func(o Snapshotter) bool {
+		key := o.SnapshotKey()
+		if _, ok := keys[key]; ok {
+			err = fmt.Errorf("duplicated snapshot key %q", key)
+			return false
+		}
+		keys[key] = struct{}{}
+		var data []byte
+		data, err = o.SaveState()
+		if err != nil {
+			err = fmt.Errorf("save %q state: %w", key, err)
+			return false
+		}
+		entries = append(entries, snapshotEntry{Key: key, Data: data})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(entries); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// LoadState restores the state saved by [SaveState].
+//
+// Every participating object gets the data saved under its key.
+// Participants that have no saved data are left untouched.
+// If some saved key has no matching participant, an error is returned
+// after all other participants are restored.
+func (s *Scene) LoadState(data []byte) error {
+	var entries []snapshotEntry
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return fmt.Errorf("decode snapshot: %w", err)
+	}
+	byKey := make(map[string][]byte, len(entries))
+	for _, e := range entries {
+		byKey[e.Key] = e.Data
+	}
+
+	var err error
+	s.eachSnapshotter(func(o Snapshotter) bool {
+		key := o.SnapshotKey()
+		data, ok := byKey[key]
+		if !ok {
+			return true
+		}
+		delete(byKey, key)
+		if err = o.LoadState(data); err != nil {
+			err = fmt.Errorf("load %q state: %w", key, err)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if _, ok := byKey[e.Key]; ok {
+			return fmt.Errorf("snapshot object %q is not found", e.Key)
+		}
+	}
+	return nil
+}
+
+func (s *Scene) eachSnapshotter(fn func(o Snapshotter) bool) {
+	if c, ok := s.controllerImpl().(Snapshotter); ok {
+		if !fn(c) {
+			return
+		}
+	}
+	s.EachObject(func(o Object) bool {
+		if sn, ok := o.(Snapshotter); ok {
+			return fn(sn)
+		}
+		return true
+	})
+}
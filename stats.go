@@ -0,0 +1,208 @@
+package gscene
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+)
+
+// FrameStats is a single frame scene statistics record.
+// See [Manager.StartStatsRecording].
+type FrameStats struct {
+	// Frame is a frame sequence number since the recording start.
+	Frame uint64 `json:"frame"`
+
+	NumObjects int `json:"objects"`
+
+	// NumGraphics is -1 if the drawer doesn't implement [GraphicsCounter].
+	NumGraphics int `json:"graphics"`
+
+	ObjectsAdded   int `json:"added"`
+	ObjectsRemoved int `json:"removed"`
+
+	FixedUpdateTime      time.Duration `json:"fixed_update_ns"`
+	ControllerUpdateTime time.Duration `json:"controller_update_ns"`
+	ObjectsUpdateTime    time.Duration `json:"objects_update_ns"`
+	PostUpdateTime       time.Duration `json:"post_update_ns"`
+	DrawerUpdateTime     time.Duration `json:"drawer_update_ns"`
+
+	// DrawTime includes the Drawer.Draw and the debug gizmos rendering.
+	DrawTime time.Duration `json:"draw_ns"`
+
+	// ObjectTypeTimes is the objects Update time grouped by the object type.
+	// It's only collected when requested via [Manager.SetMetricsSink].
+	// The map is re-used between the frames, don't retain it.
+	ObjectTypeTimes map[reflect.Type]time.Duration `json:"-"`
+}
+
+// StatsFormat is a scene statistics output format.
+type StatsFormat int
+
+const (
+	// StatsCSV writes a header line followed by one CSV record per frame.
+	// All durations are written in nanoseconds.
+	StatsCSV StatsFormat = iota
+
+	// StatsNDJSON writes one JSON object per line.
+	// All durations are written in nanoseconds.
+	StatsNDJSON
+)
+
+type statsRecorder struct {
+	w      io.Writer
+	format StatsFormat
+	frame  uint64
+	err    error
+}
+
+// StartStatsRecording makes the manager append per-frame scene statistics
+// to the provided writer until [StopStatsRecording] is called.
+//
+// A record is written after every [Manager.Draw] call.
+// The recorded data can be used to graph and compare
+// the performance across the game builds.
+//
+// Collecting the statistics has some overhead, so it should
+// be only enabled for the profiling sessions.
+func (m *Manager) StartStatsRecording(w io.Writer, format StatsFormat) {
+	m.statsRecorder = &statsRecorder{w: w, format: format}
+	if format == StatsCSV {
+		m.statsRecorder.writeCSVHeader()
+	}
+	m.syncStatsEnabled()
+}
+
+// StopStatsRecording stops the recording started by [StartStatsRecording].
+// It returns the first write error encountered during the recording (if any).
+func (m *Manager) StopStatsRecording() error {
+	r := m.statsRecorder
+	if r == nil {
+		return nil
+	}
+	m.statsRecorder = nil
+	m.syncStatsEnabled()
+	return r.err
+}
+
+// statsEnabled reports whether some manager subsystem needs the scene stats.
+func (m *Manager) statsEnabled() bool {
+	return m.statsRecorder != nil || m.debugOverlay != nil || m.frameGraph != nil ||
+		m.metricsSink != nil
+}
+
+func (m *Manager) syncStatsEnabled() {
+	s := m.currentScene
+	if s == nil {
+		return
+	}
+	enabled := m.statsEnabled()
+	if enabled && !s.statsEnabled {
+		// Discard the counters accumulated while
+		// the stats collection was disabled.
+		s.stats = FrameStats{}
+	}
+	s.statsEnabled = enabled
+	s.profileObjectTypes = m.metricsSink != nil && m.metricsByType
+}
+
+// SetMetricsSink installs a function that receives the per-frame
+// scene metrics after every [Manager.Draw] call.
+//
+// The metrics include the timings of the Controller.Update,
+// objects Update, Drawer.Update and Drawer.Draw, so the games
+// can plot the frame budgets in their own tooling.
+//
+// If byObjectType is true, the objects Update time is also
+// grouped by the concrete object type (see [FrameStats.ObjectTypeTimes]).
+// This makes the profiling overhead significantly higher.
+//
+// The stats pointer is only valid during the sink call.
+// A nil sink disables the metrics reporting.
+func (m *Manager) SetMetricsSink(sink func(stats *FrameStats), byObjectType bool) {
+	m.metricsSink = sink
+	m.metricsByType = byObjectType
+	m.syncStatsEnabled()
+}
+
+// finishFrameStats is called after the frame is drawn.
+// It passes the collected stats to the interested subsystems.
+func (m *Manager) finishFrameStats() {
+	s := m.currentScene
+	stats := s.stats
+	s.stats = FrameStats{}
+	if stats.ObjectTypeTimes != nil {
+		defer func() {
+			clear(stats.ObjectTypeTimes)
+			s.stats.ObjectTypeTimes = stats.ObjectTypeTimes
+		}()
+	}
+
+	stats.NumObjects = s.NumObjects()
+	stats.NumGraphics = s.NumGraphics()
+
+	if m.statsRecorder != nil {
+		stats.Frame = m.statsRecorder.frame
+		m.statsRecorder.frame++
+		m.statsRecorder.write(&stats)
+	}
+	if m.debugOverlay != nil {
+		m.debugOverlay.push(&stats)
+	}
+	if m.frameGraph != nil {
+		m.frameGraph.push(&stats)
+	}
+	if m.metricsSink != nil {
+		m.metricsSink(&stats)
+	}
+}
+
+func (r *statsRecorder) writeCSVHeader() {
+	r.setErr(fmt.Fprintln(r.w, "frame,objects,graphics,added,removed,fixed_update_ns,controller_update_ns,objects_update_ns,post_update_ns,drawer_update_ns,draw_ns"))
+}
+
+func (r *statsRecorder) write(stats *FrameStats) {
+	if r.err != nil {
+		return
+	}
+	switch r.format {
+	case StatsCSV:
+		r.setErr(fmt.Fprintf(r.w, "%d,%d,%d,%d,%d,%d,%d,%d,%d,%d,%d\n",
+			stats.Frame, stats.NumObjects, stats.NumGraphics,
+			stats.ObjectsAdded, stats.ObjectsRemoved,
+			stats.FixedUpdateTime.Nanoseconds(), stats.ControllerUpdateTime.Nanoseconds(),
+			stats.ObjectsUpdateTime.Nanoseconds(), stats.PostUpdateTime.Nanoseconds(),
+			stats.DrawerUpdateTime.Nanoseconds(), stats.DrawTime.Nanoseconds()))
+	case StatsNDJSON:
+		data, err := json.Marshal(stats)
+		if err != nil {
+			r.err = err
+			return
+		}
+		data = append(data, '\n')
+		r.setErr(r.w.Write(data))
+	}
+}
+
+func (r *statsRecorder) setErr(_ int, err error) {
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+func (s *Scene) statsStart() time.Time {
+	if !s.statsEnabled {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+func (s *Scene) statsLap(dst *time.Duration, start time.Time) time.Time {
+	if !s.statsEnabled {
+		return start
+	}
+	now := time.Now()
+	*dst += now.Sub(start)
+	return now
+}
@@ -0,0 +1,47 @@
+package gscene
+
+// SetStepMode enables or disables the frame-step debugging mode.
+//
+// In the step mode, [UpdateWithDelta] doesn't run the scene Update tree
+// unless a step was requested via [StepOnce].
+// The Draw calls keep working as usual, so the frozen frame
+// can be inspected (with the debug gizmos, for example).
+//
+// The debug hotkeys are still handled in the step mode.
+// [RunHeadless] is not affected by this mode.
+//
+// It's intended to be used in the dev builds only.
+func (m *Manager) SetStepMode(enabled bool) {
+	m.stepMode = enabled
+	m.stepPending = false
+}
+
+// StepModeEnabled reports whether the frame-step mode is enabled.
+// See [SetStepMode].
+func (m *Manager) StepModeEnabled() bool {
+	return m.stepMode
+}
+
+// StepOnce makes the next [UpdateWithDelta] call execute
+// exactly one frame while the step mode is enabled.
+//
+// Multiple StepOnce calls during a single frame result in one step.
+// The frame skipping (see [EnableFrameSkip]) doesn't apply to the steps.
+// It has no effect if the step mode is disabled.
+func (m *Manager) StepOnce() {
+	if m.stepMode {
+		m.stepPending = true
+	}
+}
+
+// stepModeFrozen reports whether the current frame update should be skipped.
+func (m *Manager) stepModeFrozen() bool {
+	if !m.stepMode {
+		return false
+	}
+	if !m.stepPending {
+		return true
+	}
+	m.stepPending = false
+	return false
+}
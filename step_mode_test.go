@@ -0,0 +1,43 @@
+package gscene_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestStepOnceWithFrameSkip(t *testing.T) {
+	clock := gscenetest.NewClock()
+	numUpdates := 0
+	m := gscene.NewManager()
+	m.ChangeScene(&gscenetest.Controller{
+		Recorder: &gscenetest.Recorder{},
+		OnUpdate: func(delta float64) {
+			numUpdates++
+		},
+	})
+	m.SetClock(clock.Now)
+	m.EnableFrameSkip(gscene.FrameSkipConfig{
+		Budget:     10 * time.Millisecond,
+		SlowFrames: 1,
+	})
+
+	// A slow frame makes the frame skipping run the extra updates.
+	dst := ebiten.NewImage(8, 8)
+	m.Draw(dst)
+	clock.Advance(50 * time.Millisecond)
+	m.Draw(dst)
+	if !m.IsFrameSkipping() {
+		t.Fatal("the frame skipping is not active")
+	}
+
+	m.SetStepMode(true)
+	m.StepOnce()
+	m.UpdateWithDelta(1.0 / 60.0)
+	if numUpdates != 1 {
+		t.Fatalf("have %d updates for a single step, want 1", numUpdates)
+	}
+}
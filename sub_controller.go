@@ -0,0 +1,61 @@
+package gscene
+
+// SubController is a temporary scene mode handler,
+// like "aiming mode" or "dialogue mode".
+// See [Scene.PushController].
+type SubController interface {
+	// Update is called before the main [Controller.Update].
+	//
+	// It reports whether the Update should be propagated further:
+	// if false is returned, the sub-controllers below it
+	// and the main controller don't get the Update call this frame.
+	Update(delta float64) bool
+}
+
+// PushController puts a sub-controller on top of the scene controller stack.
+//
+// The sub-controllers intercept the Update before the main controller:
+// the topmost one is updated first.
+// This way, the game modes can be implemented as separate
+// types instead of a giant switch inside a single controller.
+//
+// The scene objects are updated as usual.
+func (s *Scene) PushController(c SubController) {
+	s.subControllers = append(s.subControllers, c)
+}
+
+// PopController removes the topmost sub-controller and returns it.
+// It panics if there are no sub-controllers.
+func (s *Scene) PopController() SubController {
+	n := len(s.subControllers)
+	if n == 0 {
+		panic("pop from an empty sub-controller stack")
+	}
+	c := s.subControllers[n-1]
+	s.subControllers[n-1] = nil
+	s.subControllers = s.subControllers[:n-1]
+	return c
+}
+
+// TopController returns the topmost sub-controller.
+// It returns nil if there are no sub-controllers.
+func (s *Scene) TopController() SubController {
+	if len(s.subControllers) == 0 {
+		return nil
+	}
+	return s.subControllers[len(s.subControllers)-1]
+}
+
+func (s *Scene) updateControllers(delta float64) {
+	// The stack can be modified during the Update calls,
+	// so the length is re-checked on every iteration.
+	for i := len(s.subControllers) - 1; i >= 0; i-- {
+		if i >= len(s.subControllers) {
+			continue
+		}
+		if !s.subControllers[i].Update(delta) {
+			return
+		}
+	}
+	s.controllerObject.Update(delta)
+}
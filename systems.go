@@ -0,0 +1,132 @@
+package gscene
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SystemConfig is an argument type for [Scene.AddSystem].
+type SystemConfig struct {
+	// Name is a unique system identifier.
+	// It's used in the Before and After constraints.
+	Name string
+
+	// Before lists the systems that should run after this one.
+	Before []string
+
+	// After lists the systems that should run before this one.
+	After []string
+
+	Update func(delta float64)
+}
+
+type updateSystem struct {
+	config SystemConfig
+	index  int
+}
+
+// AddSystem registers an update system.
+//
+// Systems are executed every Update, right after all objects Update
+// (before the [PhaseLogic] handlers).
+// Their execution order is defined by the Before and After constraints,
+// so a spawner can be declared to run after the collision resolution
+// without relying on the registration order.
+// Systems that are not constrained relative to each other
+// run in the order they were registered.
+//
+// The constraints that refer to the unknown systems are ignored,
+// so the optional systems can be referenced safely.
+// The order is computed once after the systems list is changed;
+// a dependency cycle causes a panic at that moment.
+//
+// It panics if a system with the same name is already registered.
+func (s *Scene) AddSystem(config SystemConfig) {
+	for _, sys := range s.systems {
+		if sys.config.Name == config.Name {
+			panic(fmt.Sprintf("%q system is already registered", config.Name))
+		}
+	}
+	s.systems = append(s.systems, &updateSystem{config: config})
+	s.systemsOrder = nil
+}
+
+// RemoveSystem removes the system registered by [AddSystem].
+// It's a no-op if there is no such system.
+func (s *Scene) RemoveSystem(name string) {
+	s.systems = slices.DeleteFunc(s.systems, func(sys *updateSystem) bool {
+		return sys.config.Name == name
+	})
+	s.systemsOrder = nil
+}
+
+func (s *Scene) runSystems(delta float64) {
+	if s.systemsOrder == nil {
+		s.systemsOrder = sortSystems(s.systems)
+	}
+	// Systems can be added or removed during the Update;
+	// the new order is applied starting from the next frame.
+	for _, sys := range s.systemsOrder {
+		sys.config.Update(delta)
+	}
+}
+
+// sortSystems returns the systems in their execution order.
+// It's a topological sort that prefers the registration order
+// among the systems that are ready to run.
+func sortSystems(systems []*updateSystem) []*updateSystem {
+	byName := make(map[string]*updateSystem, len(systems))
+	for i, sys := range systems {
+		sys.index = i
+		byName[sys.config.Name] = sys
+	}
+
+	// deps[i] lists the systems that should run before systems[i].
+	deps := make([][]int, len(systems))
+	for i, sys := range systems {
+		for _, name := range sys.config.After {
+			if other, ok := byName[name]; ok {
+				deps[i] = append(deps[i], other.index)
+			}
+		}
+		for _, name := range sys.config.Before {
+			if other, ok := byName[name]; ok {
+				deps[other.index] = append(deps[other.index], i)
+			}
+		}
+	}
+
+	order := make([]*updateSystem, 0, len(systems))
+	done := make([]bool, len(systems))
+	for len(order) < len(systems) {
+		next := -1
+		for i := range systems {
+			if done[i] {
+				continue
+			}
+			ready := true
+			for _, j := range deps[i] {
+				if !done[j] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			var names []string
+			for i, sys := range systems {
+				if !done[i] {
+					names = append(names, sys.config.Name)
+				}
+			}
+			panic(fmt.Sprintf("update systems dependency cycle among %q", names))
+		}
+		done[next] = true
+		order = append(order, systems[next])
+	}
+	return order
+}
@@ -0,0 +1,89 @@
+package gscene
+
+import (
+	"time"
+)
+
+// Task is a long-running job executed in small steps by the scene.
+// See [Scene.RunTask].
+type Task interface {
+	// Step executes the next portion of the task work.
+	//
+	// The budget is the amount of time this step can take;
+	// it's up to the task to respect it.
+	// Step should return whether the task is complete
+	// and the task progress in [0, 1] range.
+	Step(budget time.Duration) (done bool, progress float64)
+}
+
+// TaskHandle is a running task state published by the scene.
+// It can be used to display the progress bar.
+type TaskHandle struct {
+	task     Task
+	progress float64
+	done     bool
+	canceled bool
+}
+
+// Progress returns the last reported task progress.
+func (h *TaskHandle) Progress() float64 { return h.progress }
+
+// IsDone reports whether the task is complete.
+// Canceled tasks are never complete.
+func (h *TaskHandle) IsDone() bool { return h.done }
+
+// Cancel stops the task execution.
+// The task Step method will not be called anymore.
+func (h *TaskHandle) Cancel() { h.canceled = true }
+
+// IsCanceled reports whether the task was canceled.
+func (h *TaskHandle) IsCanceled() bool { return h.canceled }
+
+// RunTask starts the cooperative task execution.
+//
+// The scene steps its tasks every frame after the objects are updated.
+// All tasks share the same per-frame time budget (see [SetTaskBudget]);
+// they're stepped in the order they were started
+// until the budget is exhausted.
+//
+// This allows procedural generation and large saves to be executed
+// in chunks inside the game loop without goroutines synchronization.
+// The tasks are discarded together with the scene.
+func (s *Scene) RunTask(t Task) *TaskHandle {
+	h := &TaskHandle{task: t}
+	s.tasks = append(s.tasks, h)
+	return h
+}
+
+// SetTaskBudget changes the per-frame time budget for the scene tasks.
+// The default budget is 4ms.
+func (s *Scene) SetTaskBudget(budget time.Duration) {
+	s.taskBudget = budget
+}
+
+func (s *Scene) stepTasks() {
+	start := time.Now()
+
+	// A task can start another task during its step,
+	// so the list is re-read on every iteration.
+	n := len(s.tasks)
+	numLive := 0
+	for i := 0; i < n; i++ {
+		h := s.tasks[i]
+		if h.canceled {
+			continue
+		}
+		remaining := s.taskBudget - time.Since(start)
+		if remaining > 0 {
+			h.done, h.progress = h.task.Step(remaining)
+			if h.done {
+				continue
+			}
+		}
+		s.tasks[numLive] = h
+		numLive++
+	}
+	numAdded := copy(s.tasks[numLive:], s.tasks[n:])
+	clear(s.tasks[numLive+numAdded:])
+	s.tasks = s.tasks[:numLive+numAdded]
+}
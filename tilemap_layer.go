@@ -0,0 +1,189 @@
+package gscene
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// TilemapConfig describes the [TilemapLayer] properties.
+type TilemapConfig struct {
+	// Tileset is an image that contains all tiles.
+	// Tiles are indexed from left to right, from top to bottom.
+	Tileset *ebiten.Image
+
+	// TileWidth and TileHeight specify the tile size in pixels.
+	TileWidth  int
+	TileHeight int
+
+	// Columns and Rows specify the tilemap size in tiles.
+	Columns int
+	Rows    int
+
+	// ChunkSize is the chunk side length in tiles.
+	// Every chunk is rendered into its own cached image.
+	//
+	// A zero value means "use the default value" (16).
+	ChunkSize int
+}
+
+// TilemapLayer is a [Graphics] that renders a tile index grid.
+//
+// The tiles are grouped into chunks; every chunk is rendered
+// once into a cached image and then re-used for all following frames.
+// Editing a tile invalidates only the chunk it belongs to.
+//
+// Chunks that are outside of the visible area are not drawn at all.
+// The visible area is the destination image bounds shifted
+// by the camera position, see [SetCameraPos].
+type TilemapLayer struct {
+	config TilemapConfig
+
+	tiles []int
+
+	chunkColumns int
+	chunkRows    int
+	chunks       []tilemapChunk
+
+	cameraPos [2]float64
+
+	disposed bool
+}
+
+type tilemapChunk struct {
+	img   *ebiten.Image
+	dirty bool
+}
+
+// NewTilemapLayer creates a tilemap graphics.
+// All tiles are empty (-1) initially.
+func NewTilemapLayer(config TilemapConfig) *TilemapLayer {
+	if config.ChunkSize == 0 {
+		config.ChunkSize = 16
+	}
+	if config.TileWidth <= 0 || config.TileHeight <= 0 {
+		panic("tilemap tile size should be positive")
+	}
+
+	l := &TilemapLayer{
+		config:       config,
+		tiles:        make([]int, config.Columns*config.Rows),
+		chunkColumns: (config.Columns + config.ChunkSize - 1) / config.ChunkSize,
+		chunkRows:    (config.Rows + config.ChunkSize - 1) / config.ChunkSize,
+	}
+	for i := range l.tiles {
+		l.tiles[i] = -1
+	}
+	l.chunks = make([]tilemapChunk, l.chunkColumns*l.chunkRows)
+	for i := range l.chunks {
+		l.chunks[i].dirty = true
+	}
+	return l
+}
+
+// SetTile assigns the tileset tile index to the specified cell.
+// A negative index makes the cell empty.
+//
+// Only the chunk that contains this cell is invalidated.
+func (l *TilemapLayer) SetTile(col, row, index int) {
+	i := row*l.config.Columns + col
+	if l.tiles[i] == index {
+		return
+	}
+	l.tiles[i] = index
+	chunkIndex := (row/l.config.ChunkSize)*l.chunkColumns + (col / l.config.ChunkSize)
+	l.chunks[chunkIndex].dirty = true
+}
+
+// Tile returns the tileset tile index of the specified cell.
+// Empty cells have a negative index.
+func (l *TilemapLayer) Tile(col, row int) int {
+	return l.tiles[row*l.config.Columns+col]
+}
+
+// SetCameraPos sets the world position of the visible area top-left corner.
+func (l *TilemapLayer) SetCameraPos(x, y float64) {
+	l.cameraPos = [2]float64{x, y}
+}
+
+// Dispose marks this graphics as disposed and releases the cached images.
+func (l *TilemapLayer) Dispose() {
+	l.disposed = true
+	for i := range l.chunks {
+		if l.chunks[i].img != nil {
+			l.chunks[i].img.Dispose()
+			l.chunks[i].img = nil
+		}
+	}
+}
+
+// IsDisposed implements the [Graphics] interface.
+func (l *TilemapLayer) IsDisposed() bool {
+	return l.disposed
+}
+
+// Draw implements the [Graphics] interface.
+func (l *TilemapLayer) Draw(dst *ebiten.Image) {
+	bounds := dst.Bounds()
+	chunkWidth := l.config.ChunkSize * l.config.TileWidth
+	chunkHeight := l.config.ChunkSize * l.config.TileHeight
+	camX := int(l.cameraPos[0])
+	camY := int(l.cameraPos[1])
+
+	for row := 0; row < l.chunkRows; row++ {
+		for col := 0; col < l.chunkColumns; col++ {
+			x := col*chunkWidth - camX
+			y := row*chunkHeight - camY
+			chunkRect := image.Rect(x, y, x+chunkWidth, y+chunkHeight)
+			if !chunkRect.Overlaps(bounds) {
+				continue
+			}
+			chunk := &l.chunks[row*l.chunkColumns+col]
+			if chunk.dirty {
+				l.renderChunk(chunk, col, row)
+			}
+			var opts ebiten.DrawImageOptions
+			opts.GeoM.Translate(float64(x), float64(y))
+			dst.DrawImage(chunk.img, &opts)
+		}
+	}
+}
+
+func (l *TilemapLayer) renderChunk(chunk *tilemapChunk, chunkCol, chunkRow int) {
+	chunk.dirty = false
+
+	size := l.config.ChunkSize
+	tw := l.config.TileWidth
+	th := l.config.TileHeight
+	if chunk.img == nil {
+		chunk.img = ebiten.NewImage(size*tw, size*th)
+	} else {
+		chunk.img.Clear()
+	}
+
+	tilesetColumns := l.config.Tileset.Bounds().Dx() / tw
+	tilesetMin := l.config.Tileset.Bounds().Min
+
+	for dy := 0; dy < size; dy++ {
+		row := chunkRow*size + dy
+		if row >= l.config.Rows {
+			break
+		}
+		for dx := 0; dx < size; dx++ {
+			col := chunkCol*size + dx
+			if col >= l.config.Columns {
+				break
+			}
+			index := l.tiles[row*l.config.Columns+col]
+			if index < 0 {
+				continue
+			}
+			srcX := tilesetMin.X + (index%tilesetColumns)*tw
+			srcY := tilesetMin.Y + (index/tilesetColumns)*th
+			tile := l.config.Tileset.SubImage(image.Rect(srcX, srcY, srcX+tw, srcY+th)).(*ebiten.Image)
+			var opts ebiten.DrawImageOptions
+			opts.GeoM.Translate(float64(dx*tw), float64(dy*th))
+			chunk.img.DrawImage(tile, &opts)
+		}
+	}
+}
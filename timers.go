@@ -0,0 +1,189 @@
+package gscene
+
+// sceneTimer is implemented by the scene-owned timers.
+type sceneTimer interface {
+	advance(delta float64)
+	base() *timerBase
+}
+
+// timerBase is a common part of the [Stopwatch] and [Cooldown].
+type timerBase struct {
+	group    UpdateGroup
+	disposed bool
+}
+
+func (t *timerBase) base() *timerBase { return t }
+
+// SetUpdateGroup binds the timer to the update group.
+// The timer doesn't advance while its group is paused
+// (see [Scene.SetGroupPaused] and [Manager.SetPaused]).
+// The default group is 0.
+func (t *timerBase) SetUpdateGroup(group UpdateGroup) {
+	t.group = group
+}
+
+// Dispose stops the timer and removes it from the scene.
+func (t *timerBase) Dispose() {
+	t.disposed = true
+}
+
+// IsDisposed reports whether the timer was disposed.
+func (t *timerBase) IsDisposed() bool {
+	return t.disposed
+}
+
+// Stopwatch measures the elapsed scene time.
+// See [Scene.NewStopwatch].
+type Stopwatch struct {
+	timerBase
+
+	elapsed float64
+	stopped bool
+}
+
+// NewStopwatch creates a running stopwatch owned by the scene.
+//
+// The scene advances its timers at the beginning of every Update
+// using the scaled delta, so they respect the time scale.
+// The timers also don't advance while the scene is not updated
+// or their update group is paused, see [Stopwatch.SetUpdateGroup].
+func (s *Scene) NewStopwatch() *Stopwatch {
+	w := &Stopwatch{}
+	s.timers = append(s.timers, w)
+	return w
+}
+
+// Elapsed returns the measured time in seconds.
+func (w *Stopwatch) Elapsed() float64 {
+	return w.elapsed
+}
+
+// Reset sets the elapsed time to 0.
+// It doesn't affect the running state.
+func (w *Stopwatch) Reset() {
+	w.elapsed = 0
+}
+
+// Start resumes the time measuring.
+func (w *Stopwatch) Start() {
+	w.stopped = false
+}
+
+// Stop suspends the time measuring.
+func (w *Stopwatch) Stop() {
+	w.stopped = true
+}
+
+// IsRunning reports whether the stopwatch is measuring the time.
+func (w *Stopwatch) IsRunning() bool {
+	return !w.stopped
+}
+
+func (w *Stopwatch) advance(delta float64) {
+	if !w.stopped {
+		w.elapsed += delta
+	}
+}
+
+// Cooldown is a timer that limits how often some action can happen.
+// See [Scene.NewCooldown].
+//
+// It replaces the ad-hoc countdowns like this one:
+//
+//	c.spawnDelay -= delta
+//	if c.spawnDelay <= 0 {
+//		c.spawnDelay = 2
+//		c.spawn()
+//	}
+//
+// With a cooldown, it becomes:
+//
+//	if c.spawnCooldown.TryTrigger() {
+//		c.spawn()
+//	}
+type Cooldown struct {
+	timerBase
+
+	duration  float64
+	remaining float64
+}
+
+// NewCooldown creates a cooldown owned by the scene.
+// The cooldown is ready initially.
+//
+// The cooldown timer follows the same rules as [Scene.NewStopwatch] does.
+func (s *Scene) NewCooldown(duration float64) *Cooldown {
+	c := &Cooldown{duration: duration}
+	s.timers = append(s.timers, c)
+	return c
+}
+
+// SetDuration changes the cooldown duration.
+// It doesn't affect the active cooldown.
+func (c *Cooldown) SetDuration(duration float64) {
+	c.duration = duration
+}
+
+// Duration returns the cooldown duration.
+func (c *Cooldown) Duration() float64 {
+	return c.duration
+}
+
+// IsReady reports whether the cooldown is over.
+func (c *Cooldown) IsReady() bool {
+	return c.remaining <= 0
+}
+
+// Remaining returns the time left until the cooldown is over.
+func (c *Cooldown) Remaining() float64 {
+	return max(0, c.remaining)
+}
+
+// Trigger starts the cooldown, even if it's not ready yet.
+func (c *Cooldown) Trigger() {
+	c.TriggerFor(c.duration)
+}
+
+// TriggerFor is like [Trigger], but it uses the specified duration
+// instead of the configured one.
+// It's useful for the randomized delays.
+func (c *Cooldown) TriggerFor(duration float64) {
+	c.remaining = duration
+}
+
+// TryTrigger starts the cooldown if it's ready.
+// It reports whether the cooldown was triggered.
+func (c *Cooldown) TryTrigger() bool {
+	if !c.IsReady() {
+		return false
+	}
+	c.Trigger()
+	return true
+}
+
+// Reset makes the cooldown ready.
+func (c *Cooldown) Reset() {
+	c.remaining = 0
+}
+
+func (c *Cooldown) advance(delta float64) {
+	if c.remaining > 0 {
+		c.remaining -= delta
+	}
+}
+
+func (s *Scene) advanceTimers(delta float64) {
+	live := s.timers[:0]
+	for _, t := range s.timers {
+		b := t.base()
+		if b.disposed {
+			continue
+		}
+		if !s.isGroupStopped(b.group) {
+			t.advance(delta)
+		}
+		live = append(live, t)
+	}
+	clear(s.timers[len(live):])
+	s.timers = live
+}
@@ -0,0 +1,209 @@
+package gscene_test
+
+import (
+	"testing"
+
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+func TestStopwatch(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	w := s.NewStopwatch()
+
+	m.UpdateWithDelta(0.25)
+	m.UpdateWithDelta(0.25)
+	if w.Elapsed() != 0.5 {
+		t.Fatalf("elapsed: have %v, want 0.5", w.Elapsed())
+	}
+
+	w.Stop()
+	m.UpdateWithDelta(0.25)
+	if w.IsRunning() || w.Elapsed() != 0.5 {
+		t.Fatalf("stopped: have %v (running=%v), want 0.5", w.Elapsed(), w.IsRunning())
+	}
+
+	w.Start()
+	w.Reset()
+	m.UpdateWithDelta(0.25)
+	if !w.IsRunning() || w.Elapsed() != 0.25 {
+		t.Fatalf("restarted: have %v (running=%v), want 0.25", w.Elapsed(), w.IsRunning())
+	}
+}
+
+func TestTimersTimeScale(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	w := s.NewStopwatch()
+	c := s.NewCooldown(1)
+	c.Trigger()
+
+	m.SetTimeScale(2)
+	m.UpdateWithDelta(0.25)
+	if w.Elapsed() != 0.5 {
+		t.Fatalf("elapsed: have %v, want 0.5", w.Elapsed())
+	}
+	if c.Remaining() != 0.5 {
+		t.Fatalf("remaining: have %v, want 0.5", c.Remaining())
+	}
+
+	m.SetTimeScale(0.5)
+	m.UpdateWithDelta(0.5)
+	if w.Elapsed() != 0.75 {
+		t.Fatalf("elapsed: have %v, want 0.75", w.Elapsed())
+	}
+	if c.Remaining() != 0.25 {
+		t.Fatalf("remaining: have %v, want 0.25", c.Remaining())
+	}
+}
+
+func TestTimersGroupPause(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	w0 := s.NewStopwatch()
+	w1 := s.NewStopwatch()
+	w1.SetUpdateGroup(1)
+	c1 := s.NewCooldown(1)
+	c1.SetUpdateGroup(1)
+	c1.Trigger()
+
+	s.SetGroupPaused(1, true)
+	m.UpdateWithDelta(0.25)
+	if w0.Elapsed() != 0.25 {
+		t.Fatalf("group 0: have %v, want 0.25", w0.Elapsed())
+	}
+	if w1.Elapsed() != 0 || c1.Remaining() != 1 {
+		t.Fatalf("paused group 1 advanced: elapsed=%v remaining=%v", w1.Elapsed(), c1.Remaining())
+	}
+
+	s.SetGroupPaused(1, false)
+	m.UpdateWithDelta(0.25)
+	if w1.Elapsed() != 0.25 || c1.Remaining() != 0.75 {
+		t.Fatalf("resumed group 1: elapsed=%v remaining=%v", w1.Elapsed(), c1.Remaining())
+	}
+}
+
+func TestTimersManagerPause(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	w0 := s.NewStopwatch()
+	w1 := s.NewStopwatch()
+	w1.SetUpdateGroup(1)
+
+	// The exempt groups keep advancing.
+	m.SetPaused(true, 1)
+	m.UpdateWithDelta(0.25)
+	if w0.Elapsed() != 0 {
+		t.Fatalf("paused group 0 advanced: %v", w0.Elapsed())
+	}
+	if w1.Elapsed() != 0.25 {
+		t.Fatalf("exempt group 1: have %v, want 0.25", w1.Elapsed())
+	}
+
+	m.SetPaused(false)
+	m.UpdateWithDelta(0.25)
+	if w0.Elapsed() != 0.25 || w1.Elapsed() != 0.5 {
+		t.Fatalf("resumed: have %v and %v, want 0.25 and 0.5", w0.Elapsed(), w1.Elapsed())
+	}
+}
+
+func TestTimersNoSceneUpdate(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	w := s.NewStopwatch()
+	c := s.NewCooldown(1)
+	c.Trigger()
+
+	m.SetStepMode(true)
+	m.UpdateWithDelta(0.25)
+	m.UpdateWithDelta(0.25)
+	if w.Elapsed() != 0 || c.Remaining() != 1 {
+		t.Fatalf("advanced without the scene update: elapsed=%v remaining=%v", w.Elapsed(), c.Remaining())
+	}
+
+	m.StepOnce()
+	m.UpdateWithDelta(0.25)
+	m.UpdateWithDelta(0.25)
+	if w.Elapsed() != 0.25 || c.Remaining() != 0.75 {
+		t.Fatalf("single step: elapsed=%v remaining=%v", w.Elapsed(), c.Remaining())
+	}
+}
+
+func TestTimersDispose(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	w := s.NewStopwatch()
+	c := s.NewCooldown(1)
+	c.Trigger()
+	live := s.NewStopwatch()
+
+	m.UpdateWithDelta(0.25)
+	w.Dispose()
+	c.Dispose()
+	if !w.IsDisposed() || !c.IsDisposed() || live.IsDisposed() {
+		t.Fatal("unexpected IsDisposed results")
+	}
+
+	m.UpdateWithDelta(0.25)
+	m.UpdateWithDelta(0.25)
+	if w.Elapsed() != 0.25 || c.Remaining() != 0.75 {
+		t.Fatalf("disposed timers advanced: elapsed=%v remaining=%v", w.Elapsed(), c.Remaining())
+	}
+	if live.Elapsed() != 0.75 {
+		t.Fatalf("live stopwatch: have %v, want 0.75", live.Elapsed())
+	}
+}
+
+func TestCooldown(t *testing.T) {
+	m := gscenetest.NewManager(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	s := m.CurrentScene()
+	c := s.NewCooldown(0.5)
+
+	if !c.IsReady() || c.Remaining() != 0 {
+		t.Fatal("a new cooldown should be ready")
+	}
+	if !c.TryTrigger() {
+		t.Fatal("TryTrigger failed for a ready cooldown")
+	}
+	if c.TryTrigger() {
+		t.Fatal("TryTrigger succeeded for an active cooldown")
+	}
+	if c.Remaining() != 0.5 {
+		t.Fatalf("remaining: have %v, want 0.5", c.Remaining())
+	}
+
+	m.UpdateWithDelta(0.25)
+	if c.IsReady() || c.TryTrigger() {
+		t.Fatal("the cooldown is over too early")
+	}
+	m.UpdateWithDelta(0.25)
+	if !c.IsReady() {
+		t.Fatal("the cooldown is not over in time")
+	}
+
+	// TriggerFor overrides the duration only once.
+	c.TriggerFor(1)
+	if c.Remaining() != 1 || c.Duration() != 0.5 {
+		t.Fatalf("TriggerFor: remaining=%v duration=%v", c.Remaining(), c.Duration())
+	}
+	// Trigger restarts an active cooldown.
+	c.Trigger()
+	if c.Remaining() != 0.5 {
+		t.Fatalf("Trigger: have %v, want 0.5", c.Remaining())
+	}
+
+	c.SetDuration(2)
+	if c.Remaining() != 0.5 {
+		t.Fatalf("SetDuration affected the active cooldown: %v", c.Remaining())
+	}
+	c.Reset()
+	if !c.TryTrigger() || c.Remaining() != 2 {
+		t.Fatalf("the new duration is not used: %v", c.Remaining())
+	}
+
+	// The remaining time never goes below zero.
+	m.UpdateWithDelta(3)
+	if c.Remaining() != 0 {
+		t.Fatalf("remaining: have %v, want 0", c.Remaining())
+	}
+}
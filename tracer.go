@@ -0,0 +1,45 @@
+package gscene
+
+// Tracer receives the scene lifecycle events.
+// See [Manager.SetTracer].
+//
+// Embed [NopTracer] to implement only the interesting methods.
+type Tracer interface {
+	// OnSceneChanged is called when a new scene is installed,
+	// right before its [Controller.Init] is called.
+	// The prev scene is nil for the first scene.
+	OnSceneChanged(prev, next *Scene)
+
+	// OnObjectAdded is called for every [Scene.AddObject],
+	// right before the object Init is called.
+	OnObjectAdded(s *Scene, o Object)
+
+	// OnObjectRemoved is called when the scene drops the object
+	// from its list at the end of the Update cycle.
+	// See [RemovalObserver].
+	OnObjectRemoved(s *Scene, o Object)
+
+	// OnGraphicsAdded is called for every graphics added to the scene.
+	OnGraphicsAdded(s *Scene, g Graphics, layer int)
+}
+
+// NopTracer is a [Tracer] implementation that does nothing.
+type NopTracer struct{}
+
+func (NopTracer) OnSceneChanged(prev, next *Scene)                {}
+func (NopTracer) OnObjectAdded(s *Scene, o Object)                {}
+func (NopTracer) OnObjectRemoved(s *Scene, o Object)              {}
+func (NopTracer) OnGraphicsAdded(s *Scene, g Graphics, layer int) {}
+
+// SetTracer installs the scene lifecycle events tracer.
+//
+// It's useful for logging or visualizing the entity churn
+// without modifying every object.
+// The tracer affects the current scene and all new scenes.
+// A nil tracer disables the tracing.
+func (m *Manager) SetTracer(t Tracer) {
+	m.tracer = t
+	if m.currentScene != nil {
+		m.currentScene.tracer = t
+	}
+}
@@ -0,0 +1,131 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Transform describes the object position, rotation, and scale.
+//
+// The zero value is an identity transform:
+// zero scale components are treated as 1.
+//
+// A logical object usually owns its Transform while its graphics
+// are bound to it via [Scene.AttachGraphics].
+// This way, there is only one source of truth: the object updates
+// the transform while the graphics read it during the Draw.
+type Transform struct {
+	Pos [2]float64
+
+	// Rotation is an angle in radians.
+	Rotation float64
+
+	Scale [2]float64
+}
+
+// GeoM returns the transform as a geometry matrix.
+// The scale is applied first, then the rotation, then the translation.
+func (t *Transform) GeoM() ebiten.GeoM {
+	var geom ebiten.GeoM
+	sx, sy := t.Scale[0], t.Scale[1]
+	if sx == 0 {
+		sx = 1
+	}
+	if sy == 0 {
+		sy = 1
+	}
+	if sx != 1 || sy != 1 {
+		geom.Scale(sx, sy)
+	}
+	if t.Rotation != 0 {
+		geom.Rotate(t.Rotation)
+	}
+	geom.Translate(t.Pos[0], t.Pos[1])
+	return geom
+}
+
+// TransformableGraphics is a [Graphics] that can be drawn with a transform.
+//
+// The geom argument maps the graphics local coordinates
+// (where (0, 0) is its origin) to the destination coordinates.
+// Drawers and viewports that understand transforms call this method
+// instead of Draw.
+type TransformableGraphics interface {
+	Graphics
+
+	DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM)
+}
+
+// InterpolatedTransformableGraphics is a [TransformableGraphics]
+// that also supports the interpolation (see [InterpolatedGraphics]).
+//
+// The graphics bound via [Scene.AttachGraphics] need it to receive
+// both the attached transform and the interpolation alpha.
+type InterpolatedTransformableGraphics interface {
+	TransformableGraphics
+
+	DrawTransformedInterpolated(dst *ebiten.Image, geom ebiten.GeoM, alpha float64)
+}
+
+// TransformDrawer is an optional interface for the [Drawer].
+//
+// It's used by the [Viewport] camera.
+type TransformDrawer interface {
+	// DrawTransformed is like Draw, but the geom is applied
+	// to every [TransformableGraphics] being drawn.
+	// Other graphics are drawn as is.
+	DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM)
+}
+
+// optionalGeoM returns nil for the identity geom.
+func optionalGeoM(geom ebiten.GeoM) *ebiten.GeoM {
+	if geom == (ebiten.GeoM{}) {
+		return nil
+	}
+	return &geom
+}
+
+// attachedGraphics binds the graphics to a transform.
+//
+// Just like the [GraphicsHandle], it forwards the [OrderedGraphics]
+// and interpolation methods to the bound graphics.
+type attachedGraphics struct {
+	g TransformableGraphics
+	t *Transform
+}
+
+func (a *attachedGraphics) Draw(dst *ebiten.Image) {
+	a.g.DrawTransformed(dst, a.t.GeoM())
+}
+
+func (a *attachedGraphics) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	local := a.t.GeoM()
+	local.Concat(geom)
+	a.g.DrawTransformed(dst, local)
+}
+
+func (a *attachedGraphics) DrawInterpolated(dst *ebiten.Image, alpha float64) {
+	if ig, ok := a.g.(InterpolatedTransformableGraphics); ok {
+		ig.DrawTransformedInterpolated(dst, a.t.GeoM(), alpha)
+		return
+	}
+	a.Draw(dst)
+}
+
+func (a *attachedGraphics) DrawOrder() int {
+	return graphicsDrawOrder(a.g)
+}
+
+func (a *attachedGraphics) IsDisposed() bool {
+	return a.g.IsDisposed()
+}
+
+// AttachGraphics is like [AddGraphics], but the graphics
+// are bound to the transform.
+//
+// Every time the graphics are drawn, the current transform value
+// is applied to them automatically.
+// The [OrderedGraphics] draw order of g is respected.
+// To be interpolated, g should implement [InterpolatedTransformableGraphics].
+func (s *Scene) AttachGraphics(g TransformableGraphics, t *Transform, layer int) {
+	s.AddGraphics(&attachedGraphics{g: g, t: t}, layer)
+}
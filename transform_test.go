@@ -0,0 +1,50 @@
+package gscene_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/quasilyte/gscene"
+	"github.com/quasilyte/gscene/gscenetest"
+)
+
+// attachableGraphics logs the transform position it was drawn with.
+type attachableGraphics struct {
+	orderedTestGraphics
+}
+
+func (g *attachableGraphics) DrawTransformed(dst *ebiten.Image, geom ebiten.GeoM) {
+	*g.log = append(*g.log, fmt.Sprintf("%s@%v", g.name, geom.Element(0, 2)))
+}
+
+// interpolatedAttachableGraphics also logs the interpolation alpha.
+type interpolatedAttachableGraphics struct {
+	attachableGraphics
+}
+
+func (g *interpolatedAttachableGraphics) DrawTransformedInterpolated(dst *ebiten.Image, geom ebiten.GeoM, alpha float64) {
+	*g.log = append(*g.log, fmt.Sprintf("%s@%v/%v", g.name, geom.Element(0, 2), alpha))
+}
+
+func TestAttachGraphicsForwarding(t *testing.T) {
+	var log []string
+	m := gscene.NewManager()
+	m.ChangeScene(&gscenetest.Controller{Recorder: &gscenetest.Recorder{}})
+	scene := m.CurrentScene()
+	scene.SetFixedDelta(0.1)
+	dst := ebiten.NewImage(8, 8)
+
+	a := &attachableGraphics{}
+	a.name, a.log, a.order = "a", &log, 1
+	b := &interpolatedAttachableGraphics{}
+	b.name, b.log = "b", &log
+	scene.AttachGraphics(a, &gscene.Transform{Pos: [2]float64{10, 0}}, 0)
+	scene.AttachGraphics(b, &gscene.Transform{Pos: [2]float64{20, 0}}, 0)
+
+	// The attached graphics are sorted by the bound graphics draw order,
+	// the interpolated ones receive the alpha with the transform.
+	m.UpdateWithDelta(0.025)
+	m.Draw(dst)
+	checkDrawLog(t, log, "b@20/0.25", "a@10")
+}
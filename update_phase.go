@@ -0,0 +1,79 @@
+package gscene
+
+// UpdatePhase is a named part of the scene Update cycle.
+// See [Scene.AddPhaseHandler].
+type UpdatePhase int
+
+const (
+	// PhaseInput is executed before the controller Update.
+	// It's a good place to poll the input devices.
+	PhaseInput UpdatePhase = iota
+
+	// PhaseLogic is executed right after all objects Update
+	// and the update systems (see [Scene.AddSystem]).
+	PhaseLogic
+
+	// PhasePhysics is executed after the logic phase,
+	// but before the [PostUpdater] objects.
+	PhasePhysics
+
+	// PhaseCleanup is executed at the end of the Update cycle,
+	// right before the disposed objects are removed.
+	// The objects disposed during this phase are removed
+	// during the same Update.
+	PhaseCleanup
+
+	numUpdatePhases
+)
+
+type phaseHandler struct {
+	owner Object
+	fn    func(delta float64)
+}
+
+// AddPhaseHandler registers a function that will be called
+// during the specified phase of every scene Update.
+//
+// The phases are executed in a fixed sequence:
+//
+//	PhaseInput -> (controller and objects Update) -> (systems) ->
+//	PhaseLogic -> PhasePhysics -> (post-updates, tasks, etc) ->
+//	PhaseCleanup
+//
+// Inside one phase, the handlers are called in the order
+// they were registered.
+// A handler that is added during the phase is called
+// starting from the next Update.
+//
+// The owner binds the handler to the object lifetime:
+// the handler is removed once the owner is disposed and
+// it's not called while the owner update group is paused.
+// A nil owner means that the handler lives as long as the scene.
+func (s *Scene) AddPhaseHandler(phase UpdatePhase, owner Object, fn func(delta float64)) {
+	s.phaseHandlers[phase] = append(s.phaseHandlers[phase], phaseHandler{owner: owner, fn: fn})
+}
+
+func (s *Scene) runPhase(phase UpdatePhase, delta float64) {
+	handlers := s.phaseHandlers[phase]
+	n := len(handlers)
+	live := handlers[:0]
+	for _, h := range handlers[:n] {
+		if h.owner != nil {
+			if h.owner.IsDisposed() {
+				continue
+			}
+			if s.isObjectPaused(h.owner) {
+				live = append(live, h)
+				continue
+			}
+		}
+		h.fn(delta)
+		live = append(live, h)
+	}
+	// The handlers could be added while this phase was running.
+	live = append(live, s.phaseHandlers[phase][n:]...)
+	if len(live) < n {
+		clear(handlers[len(live):n])
+	}
+	s.phaseHandlers[phase] = live
+}
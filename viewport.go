@@ -0,0 +1,543 @@
+package gscene
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ViewportScaleMode specifies how the viewport image is scaled to the screen.
+type ViewportScaleMode int
+
+const (
+	// ViewportStretch scales the viewport image to fill
+	// the entire destination, ignoring the aspect ratio.
+	// This is a default mode.
+	ViewportStretch ViewportScaleMode = iota
+
+	// ViewportPixelPerfect scales the viewport image by the largest
+	// integer factor that fits the destination (at least 1).
+	// The nearest-neighbor filter is used and the image is centered.
+	// This mode gives crisp output for the pixel-art games.
+	ViewportPixelPerfect
+
+	// ViewportFit scales the viewport image by the largest factor
+	// that fits the destination while keeping the aspect ratio.
+	// The image is centered; the remaining destination area
+	// forms the letterbox (or pillarbox) bars.
+	ViewportFit
+)
+
+// ViewportConfig is an argument type for [NewViewport].
+type ViewportConfig struct {
+	// Width and Height specify the internal viewport image size.
+	// The scene graphics are rendered into this image
+	// and then it's scaled to the destination.
+	Width  int
+	Height int
+
+	Scale ViewportScaleMode
+
+	// BarColor is used to fill the destination area
+	// that is not covered by the viewport image.
+	// A nil value means that this area is not filled.
+	BarColor color.Color
+
+	// Rect is the destination area for the viewport,
+	// relative to the destination image bounds.
+	// An empty rect means "the entire destination".
+	//
+	// It's useful for the secondary viewports like minimaps
+	// that occupy only a corner of the screen.
+	Rect image.Rectangle
+
+	// Layers is a subset of the drawer layers to render.
+	// A nil slice means "all layers".
+	//
+	// A non-nil value requires the drawer to implement [LayersDrawer]
+	// (both built-in drawers do).
+	Layers []int
+
+	// Compose replaces the default compositing step
+	// (drawing the scaled viewport image to the destination).
+	//
+	// It's called with the rendered viewport image (see [Viewport.Target])
+	// and the destination area it should cover (see [Viewport.ContentRect]).
+	// This way, a custom shader like a CRT effect can be applied
+	// to the game view but not to the UI rendered by another viewport.
+	Compose func(dst, target *ebiten.Image, rect image.Rectangle)
+}
+
+// CameraFollowConfig is an argument type for [Viewport.Follow].
+type CameraFollowConfig struct {
+	// Deadzone is the size of the area around the camera center
+	// (in world units) where the target can move freely
+	// without moving the camera.
+	// A zero value means "no deadzone".
+	Deadzone [2]float64
+
+	// Smoothing is the camera catch-up rate.
+	// Higher values make the camera move faster;
+	// the camera covers ~63% of the remaining distance
+	// in 1/Smoothing seconds.
+	// A zero value means that the camera snaps to the target instantly.
+	Smoothing float64
+}
+
+// Viewport is a [Drawer] that renders another drawer graphics
+// into a fixed-size internal image and then scales that image
+// to the destination.
+//
+// This way, the game can use a fixed logical resolution
+// regardless of the window size.
+//
+//	func (c *myController) Init(ctx gscene.InitContext) {
+//		ctx.SetDrawer(gscene.NewViewport(gscene.NewLayeredDrawer(3), gscene.ViewportConfig{
+//			Width:  320,
+//			Height: 240,
+//			Scale:  gscene.ViewportPixelPerfect,
+//		}))
+//	}
+//
+// If the wrapped drawer is nil, a default single-layer drawer is used.
+//
+// The viewport has a camera, see [SetCameraPos] and [SetCameraZoom].
+// The camera transform is applied to the [TransformableGraphics]
+// if the wrapped drawer implements [TransformDrawer] (both built-in drawers do).
+// Other graphics are drawn in the viewport coordinates,
+// which makes them suitable for the HUD elements.
+//
+// Several viewports can share the same drawer.
+// A minimap is a secondary viewport with a different zoom and
+// a subset of layers that is added to the scene as a [Graphics]:
+//
+//	world := gscene.NewViewport(gscene.NewLayeredDrawer(3), mainConfig)
+//	ctx.SetDrawer(world)
+//	minimap := gscene.NewViewport(world.Drawer(), gscene.ViewportConfig{
+//		Width:  160,
+//		Height: 120,
+//		Rect:   image.Rect(0, 0, 160, 120),
+//		Layers: []int{0, 1}, // Without the UI layer
+//	})
+//	minimap.SetCameraZoom(0.25)
+//	ctx.Scene.AddGraphics(minimap, 2)
+//
+// A viewport that is used as a graphics only draws the shared drawer;
+// it's up to the scene drawer to Update it.
+type Viewport struct {
+	drawerProxy
+
+	config ViewportConfig
+
+	cameraPos  [2]float64
+	cameraZoom float64
+
+	follow       *[2]float64
+	followConfig CameraFollowConfig
+
+	shakeAmplitude float64
+	shakeDuration  float64
+	shakeTime      float64
+	shakeOffset    [2]float64
+
+	buf *ebiten.Image
+
+	content image.Rectangle
+
+	drawing  bool
+	disposed bool
+}
+
+// NewViewport creates a viewport drawer.
+func NewViewport(d Drawer, config ViewportConfig) *Viewport {
+	if config.Width <= 0 || config.Height <= 0 {
+		panic("viewport size should be positive")
+	}
+	if d == nil {
+		d = newSimpleDrawer()
+	}
+	if config.Layers != nil {
+		if _, ok := d.(LayersDrawer); !ok {
+			panic("the viewport drawer doesn't implement LayersDrawer")
+		}
+	}
+	return &Viewport{
+		drawerProxy: drawerProxy{drawer: d},
+		config:      config,
+		// The default camera is an identity transform.
+		cameraPos:  [2]float64{float64(config.Width) / 2, float64(config.Height) / 2},
+		cameraZoom: 1,
+	}
+}
+
+// SetCameraPos sets the world position that is displayed
+// at the center of the viewport.
+//
+// The initial camera position is (Width/2, Height/2),
+// so the world and viewport coordinates are identical.
+func (v *Viewport) SetCameraPos(x, y float64) {
+	v.cameraPos = [2]float64{x, y}
+}
+
+// CameraPos returns the current camera position.
+func (v *Viewport) CameraPos() (float64, float64) {
+	return v.cameraPos[0], v.cameraPos[1]
+}
+
+// SetCameraZoom sets the camera zoom factor.
+// Values above 1 zoom in, values below 1 zoom out.
+// The default zoom is 1.
+func (v *Viewport) SetCameraZoom(zoom float64) {
+	if zoom <= 0 {
+		panic("camera zoom should be positive")
+	}
+	v.cameraZoom = zoom
+}
+
+// CameraZoom returns the current camera zoom factor.
+func (v *Viewport) CameraZoom() float64 {
+	return v.cameraZoom
+}
+
+// Follow makes the camera follow the target position.
+// The position is read during every [UpdateCamera] call,
+// so it can be a pointer to the object [Transform] Pos field.
+//
+// Passing a nil target stops the following.
+func (v *Viewport) Follow(target *[2]float64, config CameraFollowConfig) {
+	v.follow = target
+	v.followConfig = config
+}
+
+// Shake starts a camera shake effect.
+//
+// The shake offset is up to amplitude world units initially
+// and it fades out linearly during the duration (in seconds).
+// A new shake replaces the current one.
+//
+// The shake doesn't affect the [CameraPos] value.
+func (v *Viewport) Shake(amplitude, duration float64) {
+	v.shakeAmplitude = amplitude
+	v.shakeDuration = duration
+	v.shakeTime = 0
+	if duration <= 0 {
+		v.shakeOffset = [2]float64{}
+	}
+}
+
+// IsShaking reports whether the camera shake effect is active.
+func (v *Viewport) IsShaking() bool {
+	return v.shakeTime < v.shakeDuration
+}
+
+// UpdateCamera runs the camera behaviors like [Follow] and [Shake].
+//
+// It's called by the viewport Update automatically.
+// Secondary viewports that are used as [Graphics]
+// (and therefore don't get their Update called)
+// should call it explicitly if they use these behaviors.
+func (v *Viewport) UpdateCamera(delta float64) {
+	if v.follow != nil {
+		v.updateFollow(delta)
+	}
+	if v.IsShaking() {
+		v.updateShake(delta)
+	}
+}
+
+func (v *Viewport) updateFollow(delta float64) {
+	config := &v.followConfig
+	for i := range v.cameraPos {
+		dist := v.follow[i] - v.cameraPos[i]
+		// Only the part of the distance that is
+		// outside of the deadzone should be covered.
+		halfzone := config.Deadzone[i] / 2
+		switch {
+		case dist > halfzone:
+			dist -= halfzone
+		case dist < -halfzone:
+			dist += halfzone
+		default:
+			continue
+		}
+		if config.Smoothing > 0 {
+			dist *= 1 - math.Exp(-config.Smoothing*delta)
+		}
+		v.cameraPos[i] += dist
+	}
+}
+
+func (v *Viewport) updateShake(delta float64) {
+	v.shakeTime += delta
+	if v.shakeTime >= v.shakeDuration {
+		v.shakeOffset = [2]float64{}
+		return
+	}
+	// A deterministic noise is used instead of the random source,
+	// so the replays render identically.
+	a := v.shakeAmplitude * (1 - v.shakeTime/v.shakeDuration)
+	v.shakeOffset = [2]float64{
+		a * math.Sin(v.shakeTime*97),
+		a * math.Cos(v.shakeTime*71),
+	}
+}
+
+// cameraGeoM maps the world coordinates to the viewport image coordinates.
+func (v *Viewport) cameraGeoM() ebiten.GeoM {
+	var geom ebiten.GeoM
+	geom.Translate(-v.cameraPos[0]-v.shakeOffset[0], -v.cameraPos[1]-v.shakeOffset[1])
+	geom.Scale(v.cameraZoom, v.cameraZoom)
+	geom.Translate(float64(v.config.Width)/2, float64(v.config.Height)/2)
+	return geom
+}
+
+// Update implements the [Drawer] interface.
+// It also updates the camera, see [UpdateCamera].
+func (v *Viewport) Update(delta float64) {
+	v.drawer.Update(delta)
+	v.UpdateCamera(delta)
+}
+
+// Target returns the viewport offscreen image.
+//
+// Every Draw renders the drawer graphics into this image first,
+// then it's composited to the destination.
+// The image has the viewport Width x Height size;
+// its contents are valid after the Draw call.
+// See also [ViewportConfig.Compose].
+func (v *Viewport) Target() *ebiten.Image {
+	v.buf = ensureBuffer(v.buf, v.config.Width, v.config.Height)
+	return v.buf
+}
+
+// IsDisposed implements the [Graphics] interface.
+func (v *Viewport) IsDisposed() bool {
+	return v.disposed
+}
+
+// Dispose marks the viewport as disposed and releases its image.
+// It's only useful when the viewport is used as a [Graphics].
+// The wrapped drawer is not affected.
+func (v *Viewport) Dispose() {
+	v.disposed = true
+	if v.buf != nil {
+		v.buf.Dispose()
+		v.buf = nil
+	}
+}
+
+// Draw implements the [Drawer] and [Graphics] interfaces.
+func (v *Viewport) Draw(dst *ebiten.Image) {
+	if v.drawing || v.disposed {
+		// A viewport that is added to its own drawer
+		// should not draw itself recursively.
+		return
+	}
+	v.drawing = true
+	defer func() { v.drawing = false }()
+
+	v.buf = ensureBuffer(v.buf, v.config.Width, v.config.Height)
+	v.buf.Clear()
+	v.drawWorld(v.buf)
+
+	area := v.area(dst.Bounds())
+	rect := v.contentRect(area)
+	v.content = rect
+	if v.config.BarColor != nil && rect != area {
+		v.fillBars(dst, area, rect)
+	}
+	if rect.Empty() {
+		return
+	}
+	if v.config.Compose != nil {
+		v.config.Compose(dst, v.buf, rect)
+		return
+	}
+	var opts ebiten.DrawImageOptions
+	opts.GeoM.Scale(
+		float64(rect.Dx())/float64(v.config.Width),
+		float64(rect.Dy())/float64(v.config.Height))
+	opts.GeoM.Translate(float64(rect.Min.X), float64(rect.Min.Y))
+	if v.config.Scale == ViewportPixelPerfect {
+		opts.Filter = ebiten.FilterNearest
+	} else {
+		opts.Filter = ebiten.FilterLinear
+	}
+	dst.DrawImage(v.buf, &opts)
+}
+
+func (v *Viewport) drawWorld(dst *ebiten.Image) {
+	geom := v.cameraGeoM()
+	if v.config.Layers != nil {
+		v.drawer.(LayersDrawer).DrawLayers(dst, geom, v.config.Layers)
+		return
+	}
+	td, ok := v.drawer.(TransformDrawer)
+	if !ok || geom == (ebiten.GeoM{}) {
+		v.drawer.Draw(dst)
+		return
+	}
+	td.DrawTransformed(dst, geom)
+}
+
+// area returns the destination area for the viewport.
+func (v *Viewport) area(bounds image.Rectangle) image.Rectangle {
+	if v.config.Rect.Empty() {
+		return bounds
+	}
+	return v.config.Rect.Add(bounds.Min).Intersect(bounds)
+}
+
+// contentRect returns the destination area covered by the viewport image.
+func (v *Viewport) contentRect(bounds image.Rectangle) image.Rectangle {
+	w, h := v.config.Width, v.config.Height
+	switch v.config.Scale {
+	case ViewportPixelPerfect:
+		k := max(1, min(bounds.Dx()/w, bounds.Dy()/h))
+		return centeredRect(bounds, w*k, h*k)
+	case ViewportFit:
+		k := min(float64(bounds.Dx())/float64(w), float64(bounds.Dy())/float64(h))
+		return centeredRect(bounds, int(float64(w)*k), int(float64(h)*k))
+	default:
+		return bounds
+	}
+}
+
+// ContentRect returns the destination area covered by the viewport image
+// during the latest Draw call.
+//
+// The area outside of this rectangle is covered by the letterbox bars
+// (if any). The input code can use it to map the pointer coordinates,
+// see [ScreenToViewport].
+func (v *Viewport) ContentRect() image.Rectangle {
+	return v.content
+}
+
+// ScreenToViewport maps the destination (screen) coordinates
+// to the viewport image coordinates.
+// It reports false if the point is outside of the content rectangle.
+func (v *Viewport) ScreenToViewport(x, y float64) (float64, float64, bool) {
+	r := v.content
+	if r.Empty() {
+		return 0, 0, false
+	}
+	vx := (x - float64(r.Min.X)) * float64(v.config.Width) / float64(r.Dx())
+	vy := (y - float64(r.Min.Y)) * float64(v.config.Height) / float64(r.Dy())
+	inside := vx >= 0 && vy >= 0 && vx < float64(v.config.Width) && vy < float64(v.config.Height)
+	return vx, vy, inside
+}
+
+// ScreenToWorld maps the destination (screen) coordinates
+// to the world coordinates using the current camera.
+// It's useful for the mouse picking.
+//
+// It reports false if the point is outside of the content rectangle.
+func (v *Viewport) ScreenToWorld(x, y float64) (float64, float64, bool) {
+	vx, vy, ok := v.ScreenToViewport(x, y)
+	if v.content.Empty() {
+		return 0, 0, false
+	}
+	geom := v.cameraGeoM()
+	geom.Invert()
+	wx, wy := geom.Apply(vx, vy)
+	return wx, wy, ok
+}
+
+// WorldToScreen maps the world coordinates to the destination (screen)
+// coordinates using the current camera.
+// It's useful for the screen-space elements that follow
+// the world objects, like the floating damage numbers.
+//
+// The result may lay outside of the content rectangle.
+func (v *Viewport) WorldToScreen(x, y float64) (float64, float64) {
+	geom := v.cameraGeoM()
+	vx, vy := geom.Apply(x, y)
+	r := v.content
+	sx := float64(r.Min.X) + vx*float64(r.Dx())/float64(v.config.Width)
+	sy := float64(r.Min.Y) + vy*float64(r.Dy())/float64(v.config.Height)
+	return sx, sy
+}
+
+func (v *Viewport) fillBars(dst *ebiten.Image, bounds, content image.Rectangle) {
+	bars := [4]image.Rectangle{
+		image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Max.X, content.Min.Y),
+		image.Rect(bounds.Min.X, content.Max.Y, bounds.Max.X, bounds.Max.Y),
+		image.Rect(bounds.Min.X, content.Min.Y, content.Min.X, content.Max.Y),
+		image.Rect(content.Max.X, content.Min.Y, bounds.Max.X, content.Max.Y),
+	}
+	for _, bar := range bars {
+		bar = bar.Intersect(bounds)
+		if bar.Empty() {
+			continue
+		}
+		dst.SubImage(bar).(*ebiten.Image).Fill(v.config.BarColor)
+	}
+}
+
+func centeredRect(bounds image.Rectangle, w, h int) image.Rectangle {
+	x := bounds.Min.X + (bounds.Dx()-w)/2
+	y := bounds.Min.Y + (bounds.Dy()-h)/2
+	return image.Rect(x, y, x+w, y+h)
+}
+
+// drawerProxy forwards the optional [Drawer] interfaces
+// to the wrapped drawer.
+type drawerProxy struct {
+	drawer Drawer
+}
+
+// Drawer returns the wrapped drawer.
+func (p *drawerProxy) Drawer() Drawer {
+	return p.drawer
+}
+
+// AddGraphics implements the [Drawer] interface.
+func (p *drawerProxy) AddGraphics(g Graphics, layer int) {
+	p.drawer.AddGraphics(g, layer)
+}
+
+// AddGraphicsBatch implements the [BatchGraphicsAdder] interface.
+func (p *drawerProxy) AddGraphicsBatch(graphics []Graphics, layer int) {
+	if b, ok := p.drawer.(BatchGraphicsAdder); ok {
+		b.AddGraphicsBatch(graphics, layer)
+		return
+	}
+	for _, g := range graphics {
+		p.drawer.AddGraphics(g, layer)
+	}
+}
+
+// SetInterpolationAlpha implements the [InterpolationAlphaSetter] interface.
+func (p *drawerProxy) SetInterpolationAlpha(alpha float64) {
+	if a, ok := p.drawer.(InterpolationAlphaSetter); ok {
+		a.SetInterpolationAlpha(alpha)
+	}
+}
+
+// ClearGraphics implements the [GraphicsClearer] interface.
+// It panics if the wrapped drawer doesn't implement it.
+func (p *drawerProxy) ClearGraphics() {
+	c, ok := p.drawer.(GraphicsClearer)
+	if !ok {
+		panic("the wrapped drawer doesn't implement GraphicsClearer")
+	}
+	c.ClearGraphics()
+}
+
+// NumGraphics implements the [GraphicsCounter] interface.
+// It returns -1 if the wrapped drawer doesn't implement it.
+func (p *drawerProxy) NumGraphics() int {
+	if c, ok := p.drawer.(GraphicsCounter); ok {
+		return c.NumGraphics()
+	}
+	return -1
+}
+
+// EachGraphics implements the [GraphicsInspector] interface.
+func (p *drawerProxy) EachGraphics(fn func(g Graphics, layer int) bool) {
+	if gi, ok := p.drawer.(GraphicsInspector); ok {
+		gi.EachGraphics(fn)
+	}
+}
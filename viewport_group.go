@@ -0,0 +1,83 @@
+package gscene
+
+import (
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ViewportGroup is a [Drawer] that renders the same graphics
+// through several viewports.
+//
+// Every viewport has its own camera, destination rect,
+// and layer subset (see [ViewportConfig]).
+// It's useful for the split-screen modes and zoomed insets:
+//
+//	group := gscene.NewViewportGroup(gscene.NewLayeredDrawer(3))
+//	main := group.AddViewport(gscene.ViewportConfig{Width: 640, Height: 480})
+//	inset := group.AddViewport(gscene.ViewportConfig{
+//		Width:  160,
+//		Height: 120,
+//		Rect:   image.Rect(470, 10, 630, 130),
+//		Layers: []int{0, 1}, // Without the UI layer
+//	})
+//	inset.SetCameraZoom(2)
+//	ctx.SetDrawer(group)
+//
+// The shared drawer is updated once per frame, while every
+// viewport camera is updated individually.
+// Viewports are drawn in the order they were added.
+//
+// If the shared drawer is nil, a default single-layer drawer is used.
+type ViewportGroup struct {
+	drawerProxy
+
+	viewports []*Viewport
+}
+
+// NewViewportGroup creates a viewport group drawer.
+func NewViewportGroup(d Drawer) *ViewportGroup {
+	if d == nil {
+		d = newSimpleDrawer()
+	}
+	return &ViewportGroup{
+		drawerProxy: drawerProxy{drawer: d},
+	}
+}
+
+// AddViewport creates a new viewport that uses the shared drawer.
+// See [NewViewport].
+func (g *ViewportGroup) AddViewport(config ViewportConfig) *Viewport {
+	v := NewViewport(g.drawer, config)
+	g.viewports = append(g.viewports, v)
+	return v
+}
+
+// RemoveViewport removes the viewport from the group.
+// The shared drawer graphics are not affected.
+func (g *ViewportGroup) RemoveViewport(v *Viewport) {
+	g.viewports = slices.DeleteFunc(g.viewports, func(other *Viewport) bool {
+		return other == v
+	})
+}
+
+// Viewports returns the group viewports in their drawing order.
+// The returned slice should not be modified.
+func (g *ViewportGroup) Viewports() []*Viewport {
+	return g.viewports
+}
+
+// Update implements the [Drawer] interface.
+func (g *ViewportGroup) Update(delta float64) {
+	g.drawer.Update(delta)
+	for _, v := range g.viewports {
+		v.UpdateCamera(delta)
+	}
+}
+
+// Draw implements the [Drawer] interface.
+func (g *ViewportGroup) Draw(dst *ebiten.Image) {
+	for _, v := range g.viewports {
+		v.Draw(dst)
+	}
+}
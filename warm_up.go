@@ -0,0 +1,35 @@
+package gscene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// SetSceneWarmUp enables or disables the scene warm-up pass.
+//
+// With the warm-up enabled, every new scene (including the pushed ones)
+// runs one zero-delta Update and one Draw into an offscreen image
+// right after its [Controller.Init].
+// This way, the shader compilation and first-use allocations
+// happen during the scene change (e.g. behind a loading screen)
+// instead of causing a hitch on the first visible frame.
+//
+// The warm-up Update is a regular Update, so the scene [Tick] is 1
+// when the first visible frame is updated.
+// The offscreen image has the size of the latest [Draw] destination;
+// the warm-up Draw is skipped if there was no Draw call yet
+// or the manager is headless.
+func (m *Manager) SetSceneWarmUp(enabled bool) {
+	m.warmUp = enabled
+}
+
+func (m *Manager) warmUpScene(s *Scene) {
+	s.updateWithDelta(0)
+	if s.disposed || s.headless || m.lastDrawSize.X == 0 || m.lastDrawSize.Y == 0 {
+		return
+	}
+	img := ebiten.NewImage(m.lastDrawSize.X, m.lastDrawSize.Y)
+	s.insideDraw = true
+	s.drawGraphics(img)
+	s.insideDraw = false
+	img.Dispose()
+}
@@ -0,0 +1,65 @@
+package gscene
+
+import (
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+type sceneWatch struct {
+	name string
+	fn   func() string
+}
+
+// AddWatch registers a named watch expression.
+//
+// The watches are evaluated and displayed every frame while
+// the debug gizmos mode is enabled (see [SetDebugGizmos]).
+// This gives a live visibility into the game state,
+// like player HP, wave number or AI state, without print spam.
+//
+// Watches are displayed in the registration order.
+// Adding a watch with an existing name replaces it.
+func (s *Scene) AddWatch(name string, fn func() string) {
+	for i := range s.watches {
+		if s.watches[i].name == name {
+			s.watches[i].fn = fn
+			return
+		}
+	}
+	s.watches = append(s.watches, sceneWatch{name: name, fn: fn})
+}
+
+// RemoveWatch removes the watch expression registered by [AddWatch].
+func (s *Scene) RemoveWatch(name string) {
+	for i := range s.watches {
+		if s.watches[i].name == name {
+			s.watches = append(s.watches[:i], s.watches[i+1:]...)
+			return
+		}
+	}
+}
+
+// EachWatch evaluates every watch expression and calls fn with the result.
+// It can be used to display the watches in a custom debug overlay.
+func (s *Scene) EachWatch(fn func(name, value string)) {
+	for _, w := range s.watches {
+		fn(w.name, w.fn())
+	}
+}
+
+func (s *Scene) drawWatches(dst *ebiten.Image) {
+	if len(s.watches) == 0 {
+		return
+	}
+	var sb strings.Builder
+	s.EachWatch(func(name, value string) {
+		sb.WriteString(name)
+		sb.WriteString(": ")
+		sb.WriteString(value)
+		sb.WriteByte('\n')
+	})
+	bounds := dst.Bounds()
+	ebitenutil.DebugPrintAt(dst, sb.String(), bounds.Min.X+4, bounds.Min.Y+4)
+}